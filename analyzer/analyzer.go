@@ -0,0 +1,135 @@
+package analyzer
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"go/build"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/messages"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Analyzer checks struct fields alignment
+var Analyzer = &analysis.Analyzer{
+	Name: "aligo",
+	Doc:  "check struct fields alignment\n\nReports structs which fields order can be optimized and suggests optimal order.",
+	URL:  "https://kaos.sh/aligo",
+	Run:  run,
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// includeABI enables diagnostics for structs with fixed layout
+var includeABI bool
+
+// keepTaggedOrder enables optimizer mode which keeps original relative order
+// of serialized fields
+var keepTaggedOrder bool
+
+// includeGenerated enables checking of structs from generated files
+var includeGenerated bool
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func init() {
+	Analyzer.Flags.BoolVar(
+		&includeABI, "include-abi", false,
+		"report structs with fixed layout (cgo, structs.HostLayout)",
+	)
+	Analyzer.Flags.BoolVar(
+		&keepTaggedOrder, "keep-tagged-order", false,
+		"keep original order of serialized fields while optimizing",
+	)
+	Analyzer.Flags.BoolVar(
+		&includeGenerated, "include-generated", false,
+		"check structs from generated files",
+	)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// run runs analysis for given package
+func run(pass *analysis.Pass) (any, error) {
+	opts := &inspect.Options{
+		Sizes:            pass.TypesSizes,
+		KeepTaggedOrder:  keepTaggedOrder,
+		IncludeGenerated: includeGenerated,
+	}
+
+	if opts.Sizes == nil {
+		opts.Sizes = types.SizesFor("gc", build.Default.GOARCH)
+	}
+
+	// Check fixed layouts on target architecture instead of host one
+	if arch := inspect.GetSizesArch(opts.Sizes); arch != "" {
+		opts.Archs = []string{arch}
+	}
+
+	pkg := inspect.ProcessFiles(pass.Fset, pass.Pkg.Path(), pass.Files, pass.TypesInfo, opts)
+	nodes := inspect.FindStructNodes(pass.Fset, pass.Files)
+
+	for _, str := range pkg.Structs {
+		node := nodes[str.Position]
+
+		if node == nil {
+			continue
+		}
+
+		reportLayoutWarnings(pass, node, str)
+
+		if str.Ignore || str.AlignedFields == nil || (str.ABI && !includeABI) {
+			continue
+		}
+
+		reportOptimalOrder(pass, node, str)
+	}
+
+	return nil, nil
+}
+
+// reportOptimalOrder reports diagnostic with suggested fix for struct
+func reportOptimalOrder(pass *analysis.Pass, node *inspect.StructNode, str *report.Struct) {
+	msg := messages.Alignment(str)
+
+	diag := analysis.Diagnostic{
+		Pos:      node.Decl.TokPos,
+		End:      node.Type.End(),
+		Category: "alignment",
+		Message:  msg,
+	}
+
+	if str.Suggestion != "" {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: "Reorder fields",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     node.Type.Pos(),
+				End:     node.Type.End(),
+				NewText: []byte(str.Suggestion),
+			}},
+		}}
+	}
+
+	pass.Report(diag)
+}
+
+// reportLayoutWarnings reports differences between Go and C layouts
+func reportLayoutWarnings(pass *analysis.Pass, node *inspect.StructNode, str *report.Struct) {
+	for _, w := range str.ReportedWarnings() {
+		pass.Report(analysis.Diagnostic{
+			Pos:      node.Decl.TokPos,
+			Category: "layout",
+			Message:  messages.StructWarning(str, w),
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package analyzer
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestAnalyzer(t *testing.T) {
+	// Expected sizes and fixed order depend on architecture
+	t.Setenv("GOARCH", "amd64")
+
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), Analyzer, "a")
+}
+
+func TestAnalyzerArch(t *testing.T) {
+	// Fixed layouts must be checked on target architecture
+	t.Setenv("GOARCH", "arm")
+
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "b")
+}
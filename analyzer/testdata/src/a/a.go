@@ -0,0 +1,58 @@
+package a
+
+import "structs"
+
+// Padded has suboptimal fields order
+type Padded /* want `^Struct Padded fields order can be optimized \(40 → 32\)$` */ struct {
+	Enabled bool   // flag
+	Count   int64  // counter
+	Ready   bool   // flag
+	Name    string // name
+}
+
+// Optimal has optimal fields order
+type Optimal struct {
+	Count   int64
+	Enabled bool
+	Ready   bool
+}
+
+// Ignored has suboptimal fields order but it is ignored
+// aligo:ignore
+type Ignored struct {
+	Enabled bool
+	Count   int64
+	Ready   bool
+}
+
+// Message is serialized to JSON
+type Message /* want `^Struct Message fields order can be optimized \(24 → 16\)\. Reordering changes order of fields in json output$` */ struct {
+	Enabled bool  `json:"enabled"`
+	Count   int64 `json:"count"`
+	Ready   bool  `json:"ready"`
+}
+
+// Budget exceeds size budget
+// aligo:maxsize 8
+type Budget /* want `^Struct Budget: Struct size 16 exceeds budget 8 on amd64, fields over budget: B$` */ struct {
+	A int64
+	B int64
+}
+
+// Host has Go-specific padding after zero-size field
+type Host /* want `^Struct Host: Trailing zero-size field B adds Go-specific padding on amd64$` */ struct {
+	_ structs.HostLayout
+	A int32
+	B [0]byte
+}
+
+// Local contains local type
+func Local() {
+	type local /* want `^Struct local fields order can be optimized \(24 → 16\)$` */ struct {
+		A bool
+		B int64
+		C bool
+	}
+
+	_ = local{}
+}
@@ -0,0 +1,10 @@
+package b
+
+import "structs"
+
+// Wide has 8-byte field which is aligned differently by C compilers on arm
+type Wide struct { // want `^Struct Wide: Field B has different offsets in Go and C layouts on arm$` `^Struct Wide: Struct has different sizes in Go and C layouts on arm$`
+	_ structs.HostLayout
+	A int32
+	B int64
+}
@@ -0,0 +1,148 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DEFAULT_BASELINE is default name of baseline file
+const DEFAULT_BASELINE = ".aligo-baseline.json"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Baseline contains known problems keyed by package path and struct name
+type Baseline struct {
+	Structs map[string]*BaselineStruct `json:"structs"`
+}
+
+// BaselineStruct contains info about known problem in struct
+type BaselineStruct struct {
+	Wasted   int64 `json:"wasted"`
+	Warnings int   `json:"warnings"`
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getBaseline creates baseline with all problems from report
+func getBaseline(r *report.Report) *Baseline {
+	result := &Baseline{Structs: map[string]*BaselineStruct{}}
+
+	for _, pkg := range r.Packages {
+		for _, str := range pkg.Structs {
+			if isProblemStruct(str) {
+				result.Structs[getBaselineKey(pkg, str)] = getBaselineStruct(str)
+			}
+		}
+	}
+
+	return result
+}
+
+// writeBaseline writes baseline with all problems from report to file
+func writeBaseline(r *report.Report, file string) (int, error) {
+	baseline := getBaseline(r)
+	data, err := json.MarshalIndent(baseline, "", "  ")
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(baseline.Structs), os.WriteFile(file, append(data, '\n'), 0644)
+}
+
+// readBaseline reads baseline from file
+func readBaseline(file string) (*Baseline, error) {
+	data, err := os.ReadFile(file)
+
+	if err != nil {
+		return nil, i18n.UI.ERRORS.CANT_READ_BASELINE.Error(file, err)
+	}
+
+	baseline := &Baseline{}
+	err = json.Unmarshal(data, baseline)
+
+	if err != nil {
+		return nil, i18n.UI.ERRORS.CANT_READ_BASELINE.Error(file, err)
+	}
+
+	return baseline, nil
+}
+
+// applyBaseline returns copy of report without known problems from baseline
+func applyBaseline(r *report.Report, baseline *Baseline) *report.Report {
+	result := &report.Report{Meta: r.Meta}
+
+	for _, pkg := range r.Packages {
+		var structs []*report.Struct
+
+		for _, str := range pkg.Structs {
+			if !baseline.IsKnown(pkg, str) {
+				structs = append(structs, str)
+			}
+		}
+
+		if len(structs) != 0 || pkg.Generated != 0 {
+			result.Packages = append(result.Packages, copyPackage(pkg, structs))
+		}
+	}
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsKnown returns true if baseline contains problems of struct and struct
+// waste didn't grow
+func (b *Baseline) IsKnown(pkg *report.Package, str *report.Struct) bool {
+	if b == nil || !isProblemStruct(str) {
+		return false
+	}
+
+	known := b.Structs[getBaselineKey(pkg, str)]
+
+	if known == nil {
+		return false
+	}
+
+	current := getBaselineStruct(str)
+
+	return current.Wasted <= known.Wasted && current.Warnings <= known.Warnings
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getBaselineKey returns key of struct in baseline
+func getBaselineKey(pkg *report.Package, str *report.Struct) string {
+	return pkg.Path + "." + str.Name
+}
+
+// getBaselineStruct returns baseline info for given struct
+func getBaselineStruct(str *report.Struct) *BaselineStruct {
+	result := &BaselineStruct{}
+
+	if !isAlignedStruct(str) {
+		result.Wasted = str.Size - str.OptimalSize
+	}
+
+	if !str.Ignore {
+		for _, w := range str.Warnings {
+			if w.Type != report.WARN_SERIALIZATION {
+				result.Warnings++
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,59 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestBaseline(t *testing.T) {
+	budget := &report.Warning{Type: report.WARN_MAX_SIZE, Arch: "amd64"}
+	serial := &report.Warning{Type: report.WARN_SERIALIZATION, Format: "json"}
+
+	baseline := getBaseline(&report.Report{Packages: []*report.Package{
+		{Path: "example.com/a", Structs: []*report.Struct{
+			{Name: "Padded", Size: 24, OptimalSize: 16},
+			{Name: "Budget", Size: 16, OptimalSize: 16, Warnings: []*report.Warning{budget}},
+			{Name: "Aligned", Size: 16, OptimalSize: 16},
+		}},
+	}})
+
+	if len(baseline.Structs) != 2 {
+		t.Fatalf("got %d structs in baseline, want 2", len(baseline.Structs))
+	}
+
+	tests := []struct {
+		name  string
+		pkg   string
+		str   *report.Struct
+		known bool
+	}{
+		{"same problem", "example.com/a", &report.Struct{Name: "Padded", Size: 24, OptimalSize: 16}, true},
+		{"less waste", "example.com/a", &report.Struct{Name: "Padded", Size: 32, OptimalSize: 28}, true},
+		{"grown waste", "example.com/a", &report.Struct{Name: "Padded", Size: 32, OptimalSize: 16}, false},
+		{"other package", "example.com/b", &report.Struct{Name: "Padded", Size: 24, OptimalSize: 16}, false},
+		{"nested package", "example.com/a/b", &report.Struct{Name: "Padded", Size: 24, OptimalSize: 16}, false},
+		{"same warning", "example.com/a", &report.Struct{Name: "Budget", Size: 16, OptimalSize: 16, Warnings: []*report.Warning{budget}}, true},
+		{"serialization warning", "example.com/a", &report.Struct{Name: "Padded", Size: 24, OptimalSize: 16, Warnings: []*report.Warning{serial}}, true},
+		{"new warning", "example.com/a", &report.Struct{Name: "Padded", Size: 24, OptimalSize: 16, Warnings: []*report.Warning{budget}}, false},
+		{"new problem", "example.com/a", &report.Struct{Name: "Aligned", Size: 24, OptimalSize: 16}, false},
+		{"no problems", "example.com/a", &report.Struct{Name: "Aligned", Size: 16, OptimalSize: 16}, false},
+	}
+
+	for _, tt := range tests {
+		pkg := &report.Package{Path: tt.pkg, Structs: []*report.Struct{tt.str}}
+
+		if got := baseline.IsKnown(pkg, tt.str); got != tt.known {
+			t.Errorf("%s: got known %t, want %t", tt.name, got, tt.known)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bufio"
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// lineRange is range of lines in file
+type lineRange struct {
+	Start int
+	End   int
+}
+
+// changes contains changed lines ranges for every file
+type changes map[string][]lineRange
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// hunkRegex is regex for hunk header in unified diff
+var hunkRegex = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,(\d+))? @@`)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getChangesFromRev returns lines changed since merge base of given git revision
+// and HEAD, including uncommitted changes and untracked files
+func getChangesFromRev(rev string) (changes, error) {
+	root, err := runGit("", "rev-parse", "--show-toplevel")
+
+	if err != nil {
+		return nil, err
+	}
+
+	rootDir := strings.TrimSpace(string(root))
+	base, err := runGit(rootDir, "merge-base", rev, "HEAD")
+
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := runGit(
+		rootDir, "diff", "--no-color", "--no-ext-diff", "--no-renames",
+		"--src-prefix=a/", "--dst-prefix=b/", "-U0", strings.TrimSpace(string(base)), "--",
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := parseDiff(diff, rootDir)
+	untracked, err := runGit(rootDir, "ls-files", "--others", "--exclude-standard", "--full-name")
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range strings.Split(string(untracked), "\n") {
+		if file != "" {
+			result.Add(filepath.Join(rootDir, file), 1, math.MaxInt)
+		}
+	}
+
+	return result, nil
+}
+
+// getChangesFromPatch returns lines changed by patch in unified diff format
+func getChangesFromPatch(file string) (changes, error) {
+	diff, err := os.ReadFile(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rootDir, _ := os.Getwd()
+	root, err := runGit("", "rev-parse", "--show-toplevel")
+
+	if err == nil {
+		rootDir = strings.TrimSpace(string(root))
+	}
+
+	return parseDiff(diff, rootDir), nil
+}
+
+// filterChanged returns copy of report without problem structs which
+// declarations don't overlap changed lines
+func filterChanged(r *report.Report, c changes) *report.Report {
+	result := &report.Report{Meta: r.Meta}
+	declRanges := map[string]map[report.Position]lineRange{}
+
+	for _, pkg := range r.Packages {
+		var structs []*report.Struct
+
+		for _, str := range pkg.Structs {
+			file := filepath.Clean(str.Position.Path)
+
+			if !isProblemStruct(str) {
+				structs = append(structs, str)
+				continue
+			}
+
+			if len(c[file]) == 0 {
+				continue
+			}
+
+			if declRanges[file] == nil {
+				declRanges[file] = getDeclRanges(file)
+			}
+
+			declRange, ok := declRanges[file][str.Position]
+
+			if !ok {
+				declRange = lineRange{str.Position.Line, str.Position.Line}
+			}
+
+			if c.IsChanged(file, declRange) {
+				structs = append(structs, str)
+			}
+		}
+
+		if len(structs) != 0 || pkg.Generated != 0 {
+			result.Packages = append(result.Packages, copyPackage(pkg, structs))
+		}
+	}
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Add adds range of changed lines
+func (c changes) Add(file string, start, end int) {
+	file = filepath.Clean(file)
+	c[file] = append(c[file], lineRange{start, end})
+}
+
+// IsChanged returns true if given range of lines overlaps changed lines
+func (c changes) IsChanged(file string, r lineRange) bool {
+	for _, cr := range c[file] {
+		if cr.Start <= r.End && cr.End >= r.Start {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseDiff parses unified diff and returns changed lines in new versions
+// of files
+func parseDiff(diff []byte, rootDir string) changes {
+	var file, oldFile string
+
+	result := changes{}
+	scanner := bufio.NewScanner(bytes.NewReader(diff))
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "--- "):
+			oldFile = parseDiffPath(strings.TrimPrefix(line, "--- "))
+
+		case strings.HasPrefix(line, "+++ "):
+			file = parseDiffPath(strings.TrimPrefix(line, "+++ "))
+
+			if file == "/dev/null" {
+				file = ""
+				continue
+			}
+
+			// Patches created without prefixes (diff.noprefix) contain paths as is
+			if oldFile == "/dev/null" || strings.HasPrefix(oldFile, "a/") {
+				file = strings.TrimPrefix(file, "b/")
+			}
+
+			file = filepath.Join(rootDir, file)
+
+		case file != "" && strings.HasPrefix(line, "@@ "):
+			m := hunkRegex.FindStringSubmatch(line)
+
+			if m == nil {
+				continue
+			}
+
+			start, _ := strconv.Atoi(m[1])
+			count := 1
+
+			if m[2] != "" {
+				count, _ = strconv.Atoi(m[2])
+			}
+
+			// Hunk without new lines is removal after line "start"
+			result.Add(file, start, start+max(count, 1)-1)
+		}
+	}
+
+	return result
+}
+
+// parseDiffPath returns path from file header of unified diff
+func parseDiffPath(path string) string {
+	if strings.HasPrefix(path, `"`) {
+		end := strings.LastIndex(path, `"`)
+
+		// Git quotes paths with special characters using C-style escapes
+		unquoted, err := strconv.Unquote(path[:end+1])
+
+		if err == nil {
+			return unquoted
+		}
+	}
+
+	path, _, _ = strings.Cut(path, "\t")
+
+	return path
+}
+
+// getDeclRanges returns lines ranges of struct declarations in given file
+func getDeclRanges(file string) map[report.Position]lineRange {
+	result := map[report.Position]lineRange{}
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+
+	if err != nil {
+		return result
+	}
+
+	for pos, node := range inspect.FindStructNodes(fset, []*ast.File{astFile}) {
+		result[pos] = lineRange{
+			fset.Position(node.Decl.Pos()).Line,
+			fset.Position(node.Decl.End()).Line,
+		}
+	}
+
+	return result
+}
+
+// runGit runs git command and returns its output
+func runGit(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+
+	var stderr bytes.Buffer
+
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+
+		if msg == "" {
+			msg = err.Error()
+		}
+
+		return nil, i18n.UI.ERRORS.GIT_ERROR.Error(strings.Join(args, " "), msg)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,75 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"reflect"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestParseDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		diff string
+		want changes
+	}{
+		{
+			"prefixed paths",
+			"diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -3 +3,2 @@\n-x\n+y\n+z\n",
+			changes{"/src/a.go": {{3, 4}}},
+		},
+		{
+			"paths without prefixes",
+			"--- b/a.go\n+++ b/a.go\n@@ -1,0 +2 @@\n+x\n",
+			changes{"/src/b/a.go": {{2, 2}}},
+		},
+		{
+			"new file",
+			"--- /dev/null\n+++ b/new.go\n@@ -0,0 +1,3 @@\n+a\n+b\n+c\n",
+			changes{"/src/new.go": {{1, 3}}},
+		},
+		{
+			"removed file",
+			"--- a/old.go\n+++ /dev/null\n@@ -1,3 +0,0 @@\n-a\n-b\n-c\n",
+			changes{},
+		},
+		{
+			"removed lines",
+			"--- a/a.go\n+++ b/a.go\n@@ -5,2 +4,0 @@\n-a\n-b\n",
+			changes{"/src/a.go": {{4, 4}}},
+		},
+		{
+			"multiple hunks and files",
+			"--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-a\n+b\n@@ -10 +10,3 @@\n-a\n+b\n+c\n+d\n" +
+				"--- a/x/b.go\n+++ b/x/b.go\n@@ -7 +7 @@\n-a\n+b\n",
+			changes{"/src/a.go": {{1, 1}, {10, 12}}, "/src/x/b.go": {{7, 7}}},
+		},
+		{
+			"quoted path",
+			"--- \"a/caf\\303\\251 \\\"1\\\".go\"\n+++ \"b/caf\\303\\251 \\\"1\\\".go\"\n@@ -1 +1 @@\n-a\n+b\n",
+			changes{"/src/café \"1\".go": {{1, 1}}},
+		},
+		{
+			"path with timestamp",
+			"--- a/a.go\t2026-01-01 00:00:00\n+++ b/a.go\t2026-01-01 00:00:01\n@@ -1 +1 @@\n-a\n+b\n",
+			changes{"/src/a.go": {{1, 1}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseDiff([]byte(tt.diff), "/src")
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
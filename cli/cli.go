@@ -8,11 +8,14 @@ package cli
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"encoding/json"
 	"fmt"
 	"go/build"
 	"go/types"
 	"os"
 	"runtime"
+	"slices"
+	"strings"
 
 	"github.com/essentialkaos/ek/v14/fmtc"
 	"github.com/essentialkaos/ek/v14/fmtutil"
@@ -33,6 +36,8 @@ import (
 
 	"github.com/essentialkaos/aligo/v2/i18n"
 	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/lsp"
+	"github.com/essentialkaos/aligo/v2/report"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -45,14 +50,37 @@ const (
 
 // Constants with options names
 const (
-	OPT_ARCH     = "a:arch"
-	OPT_STRUCT   = "s:struct"
-	OPT_TAGS     = "t:tags"
-	OPT_PAGER    = "P:pager"
-	OPT_EXCLUDE  = "e:exclude"
-	OPT_NO_COLOR = "nc:no-color"
-	OPT_HELP     = "h:help"
-	OPT_VER      = "v:version"
+	OPT_ARCH        = "a:arch"
+	OPT_STRUCT      = "s:struct"
+	OPT_TAGS        = "t:tags"
+	OPT_PAGER       = "P:pager"
+	OPT_EXCLUDE     = "e:exclude"
+	OPT_INCLUDE     = "I:include"
+	OPT_EXCL_STRUCT = "exclude-structs"
+	OPT_INCL_STRUCT = "include-structs"
+	OPT_INCLUDE_ABI = "include-abi"
+	OPT_KEEP_ORDER  = "keep-tagged-order"
+	OPT_INCLUDE_GEN = "include-generated"
+	OPT_NO_ABI      = "no-include-abi"
+	OPT_NO_ORDER    = "no-keep-tagged-order"
+	OPT_NO_GEN      = "no-include-generated"
+	OPT_STRIP       = "strip"
+	OPT_FORMAT      = "f:format"
+	OPT_FIELDS      = "fields"
+	OPT_ABSOLUTE    = "absolute-paths"
+	OPT_INPUT       = "i:input"
+	OPT_BASELINE    = "B:baseline"
+	OPT_WRITE_BASE  = "write-baseline"
+	OPT_NEW_REV     = "new-from-rev"
+	OPT_NEW_PATCH   = "new-from-patch"
+	OPT_THRESHOLD   = "threshold"
+	OPT_LOCKED      = "locked"
+	OPT_LOCK_FILE   = "lock-file"
+	OPT_UPDATE      = "update"
+	OPT_WORKFLOW    = "workflow"
+	OPT_NO_COLOR    = "nc:no-color"
+	OPT_HELP        = "h:help"
+	OPT_VER         = "v:version"
 
 	OPT_VERB_VER     = "vv:verbose-version"
 	OPT_COMPLETION   = "completion"
@@ -60,22 +88,50 @@ const (
 )
 
 const (
-	CMD_VIEW  = "view"
-	CMD_CHECK = "check"
+	CMD_VIEW     = "view"
+	CMD_CHECK    = "check"
+	CMD_LSP      = "lsp"
+	CMD_ANNOTATE = "annotate"
+	CMD_COMPARE  = "compare"
+	CMD_LOCK     = "lock"
+	CMD_INIT     = "init"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // Options map
 var optMap = options.Map{
-	OPT_ARCH:     {},
-	OPT_STRUCT:   {},
-	OPT_TAGS:     {Mergeble: true},
-	OPT_PAGER:    {Type: options.BOOL},
-	OPT_EXCLUDE:  {Mergeble: true},
-	OPT_NO_COLOR: {Type: options.BOOL},
-	OPT_HELP:     {Type: options.BOOL},
-	OPT_VER:      {Type: options.MIXED},
+	OPT_ARCH:        {Mergeble: true},
+	OPT_STRUCT:      {Mergeble: true},
+	OPT_TAGS:        {Mergeble: true},
+	OPT_PAGER:       {Type: options.BOOL},
+	OPT_EXCLUDE:     {Mergeble: true},
+	OPT_INCLUDE:     {Mergeble: true},
+	OPT_EXCL_STRUCT: {Mergeble: true},
+	OPT_INCL_STRUCT: {Mergeble: true},
+	OPT_INCLUDE_ABI: {Type: options.BOOL},
+	OPT_KEEP_ORDER:  {Type: options.BOOL},
+	OPT_INCLUDE_GEN: {Type: options.BOOL},
+	OPT_NO_ABI:      {Type: options.BOOL, Conflicts: OPT_INCLUDE_ABI},
+	OPT_NO_ORDER:    {Type: options.BOOL, Conflicts: OPT_KEEP_ORDER},
+	OPT_NO_GEN:      {Type: options.BOOL, Conflicts: OPT_INCLUDE_GEN},
+	OPT_STRIP:       {Type: options.BOOL},
+	OPT_FORMAT:      {Value: FORMAT_TEXT},
+	OPT_FIELDS:      {Type: options.BOOL},
+	OPT_ABSOLUTE:    {Type: options.BOOL},
+	OPT_INPUT:       {},
+	OPT_BASELINE:    {},
+	OPT_WRITE_BASE:  {Type: options.BOOL},
+	OPT_NEW_REV:     {},
+	OPT_NEW_PATCH:   {},
+	OPT_THRESHOLD:   {Type: options.INT, Min: 0},
+	OPT_LOCKED:      {Type: options.BOOL},
+	OPT_LOCK_FILE:   {},
+	OPT_UPDATE:      {Type: options.BOOL},
+	OPT_WORKFLOW:    {Type: options.BOOL},
+	OPT_NO_COLOR:    {Type: options.BOOL},
+	OPT_HELP:        {Type: options.BOOL},
+	OPT_VER:         {Type: options.MIXED},
 
 	OPT_VERB_VER:     {Type: options.BOOL},
 	OPT_COMPLETION:   {},
@@ -118,7 +174,20 @@ func Run(gitRev string, gomod []byte) {
 			WithApps(apps.Golang()).
 			Print()
 		os.Exit(0)
-	case options.GetB(OPT_HELP) || len(args) < 2:
+	case options.GetB(OPT_HELP) || len(args) == 0:
+		genUsage().Print()
+		os.Exit(0)
+	}
+
+	err := loadConfig()
+
+	if err != nil {
+		terminal.Error(err)
+		os.Exit(1)
+	}
+
+	if len(args) < 2 && !args.Get(0).Is(CMD_LSP) && !args.Get(0).Is(CMD_INIT) &&
+		!options.Has(OPT_INPUT) && len(config.Paths) == 0 {
 		genUsage().Print()
 		os.Exit(0)
 	}
@@ -163,17 +232,70 @@ func configureUI() {
 }
 
 // prepare configures inspector
-func prepare() error {
-	arch := build.Default.GOARCH
+func prepare(input *report.Report) error {
+	archs := []string{build.Default.GOARCH}
 
-	if options.Has(OPT_ARCH) {
-		arch = options.GetS(OPT_ARCH)
+	switch {
+	case input != nil && input.Meta != nil && input.Meta.Arch != "":
+		// Saved report must be processed with the same sizes model
+		archs = input.Meta.Archs
+
+		if len(archs) == 0 {
+			archs = []string{input.Meta.Arch}
+		}
+	case len(getArchs()) != 0:
+		archs = getArchs()
 	}
 
-	inspect.Sizes = types.SizesFor("gc", arch)
+	for _, arch := range archs {
+		if types.SizesFor("gc", arch) == nil {
+			return i18n.UI.ERRORS.UNKNOWN_ARCH.Error(arch)
+		}
+	}
+
+	// Use archs from lock file if they are not defined explicitly and check
+	// that explicitly defined archs match archs from lock file
+	if (options.GetB(OPT_LOCKED) || options.GetB(OPT_UPDATE)) && input == nil {
+		lockFile := getLockFile()
+		lock, err := readLock(lockFile)
+
+		switch {
+		case err != nil && options.GetB(OPT_LOCKED):
+			return err
+		case err != nil || len(lock.Archs) == 0:
+			// There is no lock file yet or it doesn't contain archs
+		case !options.Has(OPT_ARCH):
+			archs = lock.Archs
+		case !isSameArchs(archs, lock.Archs):
+			return i18n.UI.ERRORS.LOCK_ARCHS.Error(
+				strings.Join(archs, ","), strings.Join(lock.Archs, ","), lockFile,
+			)
+		}
+	}
+
+	inspect.Sizes = types.SizesFor("gc", archs[0])
+	inspect.Archs = archs
+	inspect.KeepTaggedOrder = getFlag(OPT_KEEP_ORDER, OPT_NO_ORDER, config.KeepTaggedOrder)
+	inspect.IncludeGenerated = getFlag(OPT_INCLUDE_GEN, OPT_NO_GEN, config.IncludeGen)
+
+	includeABI = getFlag(OPT_INCLUDE_ABI, OPT_NO_ABI, config.IncludeABI)
+
+	var err error
 
-	if inspect.Sizes == nil {
-		return i18n.UI.ERRORS.UNKNOWN_ARCH.Error(arch)
+	filter, err = getFilter()
+
+	if err != nil {
+		return err
+	}
+
+	structSelectors, err = parseSelectors(options.GetS(OPT_STRUCT))
+
+	if err != nil {
+		return err
+	}
+
+	if input != nil && (input.Meta == nil || input.Meta.Arch == "") {
+		input.Meta = getReportMeta()
 	}
 
 	return nil
@@ -181,18 +303,38 @@ func prepare() error {
 
 // process starts source code processing
 func process(args options.Arguments) (error, bool) {
-	err := prepare()
+	cmd := args.Get(0).ToLower().String()
+	input, err := getInput(cmd)
 
 	if err != nil {
 		return err, false
 	}
 
-	cmd := args.Get(0).ToLower().String()
-	dirs := args.Strings()[1:]
-	tags := strutil.Fields(options.GetS(OPT_TAGS))
-	excludes := strutil.Fields(options.GetS(OPT_EXCLUDE))
+	err = prepare(input)
+
+	if err != nil {
+		return err, false
+	}
+
+	format := getFormat()
+
+	if cmd == CMD_LSP {
+		return startLanguageServer()
+	}
+
+	if !slices.Contains(formats, format) {
+		return i18n.UI.ERRORS.UNSUPPORTED_FORMAT.Error(format), false
+	}
 
-	report, err := inspect.ProcessSources(dirs, tags, excludes)
+	if cmd == CMD_COMPARE {
+		return compare(args[1:], format)
+	}
+
+	if cmd == CMD_INIT {
+		return initProject(args.Strings()[1:])
+	}
+
+	report, err := getReport(getPaths(args), input)
 
 	if err != nil {
 		return err, false
@@ -202,34 +344,327 @@ func process(args options.Arguments) (error, bool) {
 		return nil, true
 	}
 
+	applyConfig(report)
+
+	generated := report.Generated()
+
+	if cmd == CMD_LOCK {
+		return lockSizes(report)
+	}
+
+	var removed []string
+
+	if cmd == CMD_CHECK || cmd == CMD_CHECK[:1] {
+		if options.GetB(OPT_LOCKED) {
+			lock, err := readLock(getLockFile())
+
+			if err != nil {
+				return err, false
+			}
+
+			removed = applyLock(report, lock)
+		}
+
+		report, err = processBaseline(report)
+
+		if err != nil || report == nil {
+			return err, err == nil
+		}
+
+		report, err = processChanges(report)
+
+		if err != nil {
+			return err, false
+		}
+	}
+
+	if format != FORMAT_TEXT {
+		err, ok := printFormatted(cmd, report, format)
+		printRemovedStructs(removed)
+		return err, ok && len(removed) == 0
+	}
+
 	if options.GetB(OPT_PAGER) {
 		if pager.Setup() == nil {
 			defer pager.Complete()
 		}
 	}
 
+	ok := true
+
 	switch cmd {
 	case CMD_VIEW, CMD_VIEW[:1]:
 		if options.Has(OPT_STRUCT) {
-			PrintStruct(report, options.GetS(OPT_STRUCT), false)
+			PrintStructs(report, structSelectors, false)
 		} else {
 			PrintFull(report)
+			printGeneratedSkipped(generated)
 		}
 
 	case CMD_CHECK, CMD_CHECK[:1]:
 		if options.Has(OPT_STRUCT) {
-			PrintStruct(report, options.GetS(OPT_STRUCT), true)
-		} else if !Check(report) {
-			return nil, false
+			ok = PrintStructs(report, structSelectors, true)
+		} else {
+			ok = Check(report, generated)
 		}
 
+	case CMD_ANNOTATE:
+		return annotateSources(report, generated, format)
+
 	default:
 		return i18n.UI.ERRORS.UNSUPPORTED_COMMAND.Error(cmd), false
 	}
 
+	printRemovedStructs(removed)
+
+	return nil, ok && len(removed) == 0
+}
+
+// getInput reads saved report if input file is set and checks that
+// command supports saved reports
+func getInput(cmd string) (*report.Report, error) {
+	if !options.Has(OPT_INPUT) {
+		return nil, nil
+	}
+
+	switch {
+	case cmd == CMD_ANNOTATE, cmd == CMD_LOCK:
+		return nil, i18n.UI.ERRORS.INPUT_CONFLICT.Error(cmd)
+	case options.GetB(OPT_WRITE_BASE):
+		return nil, i18n.UI.ERRORS.INPUT_CONFLICT.Error(options.F(OPT_WRITE_BASE))
+	}
+
+	return readReport(options.GetS(OPT_INPUT))
+}
+
+// getReport analyzes sources or returns saved report if it is set
+func getReport(dirs []string, input *report.Report) (*report.Report, error) {
+	if input != nil {
+		filter.Apply(input)
+		return input, nil
+	}
+
+	r, err := inspect.ProcessSources(dirs, getTags(), filter, inspect.DefaultOptions())
+
+	if r != nil {
+		r.Meta = getReportMeta()
+	}
+
+	return r, err
+}
+
+// readReport reads report saved in JSON format
+func readReport(file string) (*report.Report, error) {
+	data, err := os.ReadFile(file)
+
+	if err != nil {
+		return nil, i18n.UI.ERRORS.CANT_READ_REPORT.Error(file, err)
+	}
+
+	r := &report.Report{}
+	err = json.Unmarshal(data, r)
+
+	if err != nil {
+		return nil, i18n.UI.ERRORS.CANT_READ_REPORT.Error(file, err)
+	}
+
+	return r, nil
+}
+
+// processBaseline writes baseline or removes known problems from report
+func processBaseline(r *report.Report) (*report.Report, error) {
+	file := getBaselineFile()
+
+	switch {
+	case options.GetB(OPT_WRITE_BASE):
+		count, err := writeBaseline(r, file)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fmtc.Printfn(i18n.UI.INFO.BASELINE_SAVED.String(), count, file)
+
+		return nil, nil
+
+	case options.Has(OPT_BASELINE) || config.Baseline != "":
+		baseline, err := readBaseline(file)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return applyBaseline(r, baseline), nil
+	}
+
+	return r, nil
+}
+
+// lockSizes writes sizes of all structs to lock file
+func lockSizes(r *report.Report) (error, bool) {
+	var prev *Lock
+
+	lockFile := getLockFile()
+	_, err := os.Stat(lockFile)
+
+	if err == nil {
+		if !options.GetB(OPT_UPDATE) {
+			return i18n.UI.ERRORS.LOCK_EXISTS.Error(lockFile), false
+		}
+
+		prev, err = readLock(lockFile)
+
+		if err != nil {
+			return err, false
+		}
+	}
+
+	count, err := writeLock(r, inspect.Archs, lockFile, prev)
+
+	if err != nil {
+		return err, false
+	}
+
+	fmtc.Printfn(i18n.UI.INFO.LOCK_SAVED.String(), count, lockFile)
+
 	return nil, true
 }
 
+// printRemovedStructs prints warnings about locked structs which don't
+// exist anymore
+func printRemovedStructs(removed []string) {
+	for _, key := range removed {
+		terminal.Warn(i18n.UI.ERRORS.LOCK_REMOVED.Error(key, getLockFile()))
+	}
+}
+
+// processChanges removes structs which weren't changed since given
+// revision or by given patch
+func processChanges(r *report.Report) (*report.Report, error) {
+	var c changes
+	var err error
+
+	switch {
+	case options.Has(OPT_NEW_REV):
+		c, err = getChangesFromRev(options.GetS(OPT_NEW_REV))
+	case options.Has(OPT_NEW_PATCH):
+		c, err = getChangesFromPatch(options.GetS(OPT_NEW_PATCH))
+	default:
+		return r, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return filterChanged(r, c), nil
+}
+
+// printFormatted prints command result in given format
+func printFormatted(cmd string, r *report.Report, format string) (error, bool) {
+	if options.Has(OPT_STRUCT) {
+		r = selectStructs(r, structSelectors)
+	}
+
+	switch cmd {
+	case CMD_VIEW, CMD_VIEW[:1]:
+		err := printReport(r, format)
+		return err, err == nil
+
+	case CMD_CHECK, CMD_CHECK[:1]:
+		problems := filterProblems(r)
+
+		// JUnit report contains all structs as passed and failed test cases
+		if format != FORMAT_JUNIT {
+			r = problems
+		}
+
+		err := printReport(r, format)
+		return err, err == nil && problems.IsEmpty()
+
+	case CMD_ANNOTATE:
+		return annotateSources(r, r.Generated(), format)
+	}
+
+	return i18n.UI.ERRORS.UNSUPPORTED_COMMAND.Error(cmd), false
+}
+
+// getReportMeta returns report metadata
+func getReportMeta() *report.Meta {
+	return &report.Meta{
+		Tool:     APP,
+		Version:  VER,
+		Arch:     inspect.Archs[0],
+		Archs:    inspect.Archs,
+		Compiler: "gc",
+		WordSize: inspect.Sizes.Sizeof(types.Typ[types.Uintptr]),
+		MaxAlign: inspect.GetMaxAlign(),
+	}
+}
+
+// annotateSources updates managed comments with layout info in sources
+func annotateSources(r *report.Report, generated int, format string) (error, bool) {
+	// Check format before modifying any source file
+	if !slices.Contains(annotateFormats, format) {
+		return i18n.UI.ERRORS.UNSUPPORTED_FORMAT.Error(format), false
+	}
+
+	strip := options.GetB(OPT_STRIP)
+	files, err := inspect.Annotate(r, strip)
+
+	if format != FORMAT_TEXT {
+		if err != nil {
+			return err, false
+		}
+
+		err = printFiles(files, generated, format)
+
+		return err, err == nil
+	}
+
+	for _, file := range files {
+		if strip {
+			fmtc.Printfn(i18n.UI.INFO.ANNOTATIONS_REMOVED.String(), file)
+		} else {
+			fmtc.Printfn(i18n.UI.INFO.ANNOTATIONS_UPDATED.String(), file)
+		}
+	}
+
+	if err != nil {
+		return err, false
+	}
+
+	if len(files) == 0 {
+		fmtc.Println(i18n.UI.INFO.ANNOTATIONS_UP_TO_DATE)
+	}
+
+	printGeneratedSkipped(generated)
+
+	return nil, true
+}
+
+// printGeneratedSkipped prints number of skipped structs from generated files
+func printGeneratedSkipped(generated int) {
+	if generated != 0 {
+		fmtc.Printfn(i18n.UI.INFO.GENERATED_SKIPPED.String(), generated)
+	}
+}
+
+// startLanguageServer starts language server which uses stdin and stdout
+// as transport
+func startLanguageServer() (error, bool) {
+	server := &lsp.Server{
+		Name:       APP,
+		Version:    VER,
+		Options:    inspect.DefaultOptions(),
+		IncludeABI: includeABI,
+	}
+
+	err := server.Serve(os.Stdin, os.Stdout)
+
+	return err, err == nil
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // printCompletion prints completion for given shell
@@ -271,11 +706,39 @@ func genUsage() *usage.Info {
 
 	info.AddCommand("check", i18n.UI.USAGE.COMMANDS.CHECK)
 	info.AddCommand("view", i18n.UI.USAGE.COMMANDS.VIEW)
+	info.AddCommand("annotate", i18n.UI.USAGE.COMMANDS.ANNOTATE)
+	info.AddCommand("compare", i18n.UI.USAGE.COMMANDS.COMPARE, "old", "new", "?path…")
+	info.AddCommand("lock", i18n.UI.USAGE.COMMANDS.LOCK, "path…")
+	info.AddCommand("init", i18n.UI.USAGE.COMMANDS.INIT, "?path…")
+	info.AddCommand("lsp", i18n.UI.USAGE.COMMANDS.LSP)
 
 	info.AddOption(OPT_ARCH, i18n.UI.USAGE.OPTIONS.ARCH, i18n.UI.USAGE.OPTIONS.ARCH_VAL)
 	info.AddOption(OPT_STRUCT, i18n.UI.USAGE.OPTIONS.STRUCT, i18n.UI.USAGE.OPTIONS.STRUCT_VAL)
 	info.AddOption(OPT_TAGS, i18n.UI.USAGE.OPTIONS.TAGS, i18n.UI.USAGE.OPTIONS.TAGS_VAL)
 	info.AddOption(OPT_EXCLUDE, i18n.UI.USAGE.OPTIONS.EXCLUDE, i18n.UI.USAGE.OPTIONS.EXCLUDE_VAL)
+	info.AddOption(OPT_INCLUDE, i18n.UI.USAGE.OPTIONS.INCLUDE, i18n.UI.USAGE.OPTIONS.EXCLUDE_VAL)
+	info.AddOption(OPT_EXCL_STRUCT, i18n.UI.USAGE.OPTIONS.EXCL_STRUCT, i18n.UI.USAGE.OPTIONS.REGEXP_VAL)
+	info.AddOption(OPT_INCL_STRUCT, i18n.UI.USAGE.OPTIONS.INCL_STRUCT, i18n.UI.USAGE.OPTIONS.REGEXP_VAL)
+	info.AddOption(OPT_INCLUDE_ABI, i18n.UI.USAGE.OPTIONS.INCLUDE_ABI)
+	info.AddOption(OPT_KEEP_ORDER, i18n.UI.USAGE.OPTIONS.KEEP_ORDER)
+	info.AddOption(OPT_INCLUDE_GEN, i18n.UI.USAGE.OPTIONS.INCLUDE_GEN)
+	info.AddOption(OPT_NO_ABI, i18n.UI.USAGE.OPTIONS.NO_ABI)
+	info.AddOption(OPT_NO_ORDER, i18n.UI.USAGE.OPTIONS.NO_KEEP_ORDER)
+	info.AddOption(OPT_NO_GEN, i18n.UI.USAGE.OPTIONS.NO_GEN)
+	info.AddOption(OPT_STRIP, i18n.UI.USAGE.OPTIONS.STRIP)
+	info.AddOption(OPT_FORMAT, i18n.UI.USAGE.OPTIONS.FORMAT, i18n.UI.USAGE.OPTIONS.FORMAT_VAL)
+	info.AddOption(OPT_FIELDS, i18n.UI.USAGE.OPTIONS.FIELDS)
+	info.AddOption(OPT_ABSOLUTE, i18n.UI.USAGE.OPTIONS.ABSOLUTE)
+	info.AddOption(OPT_INPUT, i18n.UI.USAGE.OPTIONS.INPUT, i18n.UI.USAGE.OPTIONS.INPUT_VAL)
+	info.AddOption(OPT_BASELINE, i18n.UI.USAGE.OPTIONS.BASELINE, i18n.UI.USAGE.OPTIONS.BASELINE_VAL)
+	info.AddOption(OPT_WRITE_BASE, i18n.UI.USAGE.OPTIONS.WRITE_BASE)
+	info.AddOption(OPT_NEW_REV, i18n.UI.USAGE.OPTIONS.NEW_REV, i18n.UI.USAGE.OPTIONS.NEW_REV_VAL)
+	info.AddOption(OPT_NEW_PATCH, i18n.UI.USAGE.OPTIONS.NEW_PATCH, i18n.UI.USAGE.OPTIONS.NEW_PATCH_VAL)
+	info.AddOption(OPT_THRESHOLD, i18n.UI.USAGE.OPTIONS.THRESHOLD, i18n.UI.USAGE.OPTIONS.THRESHOLD_VAL)
+	info.AddOption(OPT_LOCKED, i18n.UI.USAGE.OPTIONS.LOCKED)
+	info.AddOption(OPT_LOCK_FILE, i18n.UI.USAGE.OPTIONS.LOCK_FILE, i18n.UI.USAGE.OPTIONS.LOCK_FILE_VAL)
+	info.AddOption(OPT_UPDATE, i18n.UI.USAGE.OPTIONS.UPDATE)
+	info.AddOption(OPT_WORKFLOW, i18n.UI.USAGE.OPTIONS.WORKFLOW)
 	info.AddOption(OPT_PAGER, i18n.UI.USAGE.OPTIONS.PAGER)
 	info.AddOption(OPT_NO_COLOR, i18n.UI.USAGE.OPTIONS.NO_COLOR)
 	info.AddOption(OPT_HELP, i18n.UI.USAGE.OPTIONS.HELP)
@@ -306,6 +769,56 @@ func genUsage() *usage.Info {
 		i18n.UI.USAGE.EXAMPLES.EXAMPLE_5,
 	)
 
+	info.AddExample(
+		"-s api.Config -s '/^Mock/' view ./...",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_15,
+	)
+
+	info.AddExample(
+		"--format json check ./...",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_7,
+	)
+
+	info.AddExample(
+		"--input report.json check",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_8,
+	)
+
+	info.AddExample(
+		"--baseline .aligo-baseline.json check ./...",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_9,
+	)
+
+	info.AddExample(
+		"--new-from-rev origin/master check ./...",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_10,
+	)
+
+	info.AddExample(
+		"--threshold 8 compare v1.0.0 HEAD ./...",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_11,
+	)
+
+	info.AddExample(
+		"--arch amd64,arm64 lock ./...",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_12,
+	)
+
+	info.AddExample(
+		"--locked check ./...",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_13,
+	)
+
+	info.AddExample(
+		"--write-baseline --workflow init",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_14,
+	)
+
+	info.AddExample(
+		"annotate ./...",
+		i18n.UI.USAGE.EXAMPLES.EXAMPLE_6,
+	)
+
 	return info
 }
 
@@ -325,7 +838,10 @@ func genAbout(gitRev string) *usage.About {
 		Copyright: i18n.UI.USAGE.COPYRIGHT.String(),
 		License:   i18n.UI.USAGE.LICENSE.End(" <https://www.apache.org/licenses/LICENSE-2.0>"),
 
-		UpdateChecker: usage.UpdateChecker{"essentialkaos/aligo", update.GitHubChecker},
+		UpdateChecker: usage.UpdateChecker{
+			Payload:   "essentialkaos/aligo",
+			CheckFunc: update.GitHubChecker,
+		},
 	}
 
 	if gitRev != "" {
@@ -0,0 +1,400 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/essentialkaos/ek/v14/fmtc"
+	"github.com/essentialkaos/ek/v14/fmtutil"
+	"github.com/essentialkaos/ek/v14/options"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Struct change statuses
+const (
+	CHANGE_ADDED   = "added"
+	CHANGE_REMOVED = "removed"
+	CHANGE_GREW    = "grew"
+	CHANGE_SHRANK  = "shrank"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// StructChange contains info about changes of struct size
+type StructChange struct {
+	Struct string        `json:"struct"`
+	Status string        `json:"status"`
+	Sizes  []*SizeChange `json:"sizes"`
+}
+
+// SizeChange contains struct sizes on single arch
+type SizeChange struct {
+	Arch    string `json:"arch"`
+	OldSize int64  `json:"old_size"`
+	NewSize int64  `json:"new_size"`
+	Delta   int64  `json:"delta"`
+}
+
+// archReports contains reports for every arch
+type archReports map[string]*report.Report
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// compare compares two reports or two git revisions
+func compare(args options.Arguments, format string) (error, bool) {
+	if len(args) < 2 {
+		return i18n.UI.ERRORS.COMPARE_ARGS.Error(), false
+	}
+
+	if format != FORMAT_TEXT && format != FORMAT_JSON {
+		return i18n.UI.ERRORS.UNSUPPORTED_FORMAT.Error(format), false
+	}
+
+	var oldReports, newReports archReports
+	var err error
+
+	oldSrc, newSrc := args.Get(0).String(), args.Get(1).String()
+
+	if isFile(oldSrc) != isFile(newSrc) {
+		return i18n.UI.ERRORS.COMPARE_MIXED.Error(oldSrc, newSrc), false
+	}
+
+	if isFile(oldSrc) {
+		oldReports, err = readArchReport(oldSrc)
+
+		if err == nil {
+			newReports, err = readArchReport(newSrc)
+		}
+	} else {
+		dirs := args.Strings()[2:]
+
+		if len(dirs) == 0 {
+			dirs = config.Paths
+		}
+
+		if len(dirs) == 0 {
+			dirs = []string{"./..."}
+		}
+
+		oldReports, err = processRevision(oldSrc, dirs)
+
+		if err == nil {
+			newReports, err = processRevision(newSrc, dirs)
+		}
+	}
+
+	if err != nil {
+		return err, false
+	}
+
+	if len(getCommonArchs(oldReports, newReports)) == 0 {
+		return i18n.UI.ERRORS.COMPARE_ARCHS.Error(
+			strings.Join(slices.Sorted(maps.Keys(oldReports)), ","),
+			strings.Join(slices.Sorted(maps.Keys(newReports)), ","),
+		), false
+	}
+
+	changes := compareReports(oldReports, newReports)
+	threshold := getThreshold()
+	ok := !slices.ContainsFunc(changes, func(c *StructChange) bool {
+		return c.Status == CHANGE_GREW && slices.ContainsFunc(c.Sizes, func(s *SizeChange) bool {
+			return s.Delta > threshold
+		})
+	})
+
+	if format == FORMAT_JSON {
+		if changes == nil {
+			changes = []*StructChange{}
+		}
+
+		err = printJSON(map[string]any{"changes": changes})
+		return err, err == nil && ok
+	}
+
+	printChanges(changes)
+
+	return nil, ok
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// readArchReport reads saved report and splits it into reports for every arch
+func readArchReport(file string) (archReports, error) {
+	r, err := readReport(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Meta == nil || r.Meta.Arch == "" {
+		r.Meta = getReportMeta()
+	}
+
+	archs := r.Meta.Archs
+
+	if len(archs) == 0 {
+		archs = []string{r.Meta.Arch}
+	}
+
+	return splitArchReport(r, archs), nil
+}
+
+// processRevision checks out given git revision into temporary worktree and
+// analyzes sources for every arch
+func processRevision(rev string, dirs []string) (archReports, error) {
+	root, err := runGit("", "rev-parse", "--show-toplevel")
+
+	if err != nil {
+		return nil, err
+	}
+
+	rootDir := strings.TrimSpace(string(root))
+	cwd, err := os.Getwd()
+
+	if err != nil {
+		return nil, err
+	}
+
+	relDir, err := filepath.Rel(rootDir, cwd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDir, err := os.MkdirTemp("", APP+"-")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(tmpDir)
+
+	worktree := filepath.Join(tmpDir, "src")
+	_, err = runGit(rootDir, "worktree", "add", "--detach", worktree, rev)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer runGit(rootDir, "worktree", "remove", "--force", worktree)
+
+	opts := inspect.DefaultOptions()
+	opts.Dir = filepath.Join(worktree, relDir)
+
+	r, err := inspect.ProcessSources(dirs, getTags(), filter, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if r == nil {
+		r = &report.Report{}
+	}
+
+	r.Meta = getReportMeta()
+
+	return splitArchReport(r, opts.Archs), nil
+}
+
+// splitArchReport creates reports with struct sizes for every given arch.
+// Size from report is used if struct doesn't contain size for arch.
+func splitArchReport(r *report.Report, archs []string) archReports {
+	result := archReports{}
+
+	for _, arch := range archs {
+		archReport := &report.Report{}
+
+		for _, pkg := range r.Packages {
+			archPkg := &report.Package{Path: pkg.Path}
+
+			for _, str := range pkg.Structs {
+				size, ok := str.ArchSizes[arch]
+
+				switch {
+				case ok:
+					archPkg.Structs = append(archPkg.Structs, &report.Struct{Name: str.Name, Size: size})
+				case r.Meta == nil || r.Meta.Arch == arch:
+					archPkg.Structs = append(archPkg.Structs, &report.Struct{Name: str.Name, Size: str.Size})
+				}
+			}
+
+			archReport.Packages = append(archReport.Packages, archPkg)
+		}
+
+		result[arch] = archReport
+	}
+
+	return result
+}
+
+// compareReports compares reports and returns list of changed structs
+func compareReports(oldReports, newReports archReports) []*StructChange {
+	var result []*StructChange
+
+	archs := getCommonArchs(oldReports, newReports)
+
+	oldSizes, newSizes := map[string]map[string]int64{}, map[string]map[string]int64{}
+	var keys []string
+
+	for _, arch := range archs {
+		oldSizes[arch] = getStructSizes(oldReports[arch])
+		newSizes[arch] = getStructSizes(newReports[arch])
+
+		for key := range oldSizes[arch] {
+			keys = append(keys, key)
+		}
+
+		for key := range newSizes[arch] {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	for _, key := range keys {
+		change := &StructChange{Struct: key}
+
+		for _, arch := range archs {
+			oldSize, hasOld := oldSizes[arch][key]
+			newSize, hasNew := newSizes[arch][key]
+
+			switch {
+			case !hasOld:
+				change.Status = CHANGE_ADDED
+			case !hasNew:
+				change.Status = CHANGE_REMOVED
+			case newSize > oldSize:
+				change.Status = CHANGE_GREW
+			case newSize < oldSize && change.Status != CHANGE_GREW:
+				change.Status = CHANGE_SHRANK
+			}
+
+			change.Sizes = append(change.Sizes, &SizeChange{
+				Arch: arch, OldSize: oldSize, NewSize: newSize, Delta: newSize - oldSize,
+			})
+		}
+
+		if change.Status != "" {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
+
+// getCommonArchs returns sorted list of archs present in both reports
+func getCommonArchs(oldReports, newReports archReports) []string {
+	var result []string
+
+	for arch := range newReports {
+		if oldReports[arch] != nil {
+			result = append(result, arch)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// getStructSizes returns sizes of all structs in report
+func getStructSizes(r *report.Report) map[string]int64 {
+	result := map[string]int64{}
+
+	for _, pkg := range r.Packages {
+		for _, str := range pkg.Structs {
+			result[pkg.Path+"."+str.Name] = str.Size
+		}
+	}
+
+	return result
+}
+
+// printChanges prints info about changed structs
+func printChanges(changes []*StructChange) {
+	if len(changes) == 0 {
+		fmtc.Println(i18n.UI.INFO.NO_CHANGES.String())
+		return
+	}
+
+	for _, status := range []string{CHANGE_GREW, CHANGE_SHRANK, CHANGE_ADDED, CHANGE_REMOVED} {
+		var header bool
+
+		for _, c := range changes {
+			if c.Status != status {
+				continue
+			}
+
+			if !header {
+				fmtutil.Separator(true, getChangeHeader(status))
+				header = true
+			}
+
+			fmtc.Printf("  {*}%s{!} ", c.Struct)
+			fmtc.Println(formatSizeChanges(c))
+		}
+
+		if header {
+			fmtc.NewLine()
+		}
+	}
+}
+
+// getChangeHeader returns header for changes with given status
+func getChangeHeader(status string) string {
+	switch status {
+	case CHANGE_GREW:
+		return i18n.UI.INFO.CHANGES_GREW.String()
+	case CHANGE_SHRANK:
+		return i18n.UI.INFO.CHANGES_SHRANK.String()
+	case CHANGE_ADDED:
+		return i18n.UI.INFO.CHANGES_ADDED.String()
+	}
+
+	return i18n.UI.INFO.CHANGES_REMOVED.String()
+}
+
+// formatSizeChanges formats size changes on every arch
+func formatSizeChanges(c *StructChange) string {
+	var result []string
+
+	for _, s := range c.Sizes {
+		switch {
+		case c.Status == CHANGE_ADDED:
+			result = append(result, fmt.Sprintf("%d {s-}(%s){!}", s.NewSize, s.Arch))
+		case c.Status == CHANGE_REMOVED:
+			result = append(result, fmt.Sprintf("%d {s-}(%s){!}", s.OldSize, s.Arch))
+		case s.Delta > 0:
+			result = append(result, fmt.Sprintf("%d → %d {r}(+%d){!} {s-}(%s){!}", s.OldSize, s.NewSize, s.Delta, s.Arch))
+		case s.Delta < 0:
+			result = append(result, fmt.Sprintf("%d → %d {g}(%d){!} {s-}(%s){!}", s.OldSize, s.NewSize, s.Delta, s.Arch))
+		default:
+			result = append(result, fmt.Sprintf("%d {s-}(%s){!}", s.NewSize, s.Arch))
+		}
+	}
+
+	return strings.Join(result, ", ")
+}
+
+// isFile returns true if given path is a regular file
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
@@ -0,0 +1,308 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// testCompareOld and testCompareNew are sources used for comparing revisions
+const (
+	testCompareOld = `package test
+
+type Same struct {
+	A int64
+}
+
+type Grown struct {
+	A int32
+}
+
+type Shrunk struct {
+	A bool
+	B int64
+	C bool
+}
+
+type Removed struct {
+	A int64
+}
+`
+
+	testCompareNew = `package test
+
+type Same struct {
+	A int64
+}
+
+type Grown struct {
+	A int32
+	B int
+}
+
+type Shrunk struct {
+	B int64
+	A bool
+	C bool
+}
+
+type Added struct {
+	A string
+}
+`
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestCompareReports(t *testing.T) {
+	tests := []struct {
+		name   string
+		old    map[string]int64
+		new    map[string]int64
+		status string
+	}{
+		{"same", map[string]int64{"amd64": 8, "386": 8}, map[string]int64{"amd64": 8, "386": 8}, ""},
+		{"added", nil, map[string]int64{"amd64": 16, "386": 8}, CHANGE_ADDED},
+		{"removed", map[string]int64{"amd64": 16, "386": 8}, nil, CHANGE_REMOVED},
+		{"grew", map[string]int64{"amd64": 4, "386": 4}, map[string]int64{"amd64": 16, "386": 8}, CHANGE_GREW},
+		{"shrank", map[string]int64{"amd64": 24, "386": 16}, map[string]int64{"amd64": 16, "386": 12}, CHANGE_SHRANK},
+		{"grew on one arch", map[string]int64{"amd64": 16, "386": 8}, map[string]int64{"amd64": 16, "386": 12}, CHANGE_GREW},
+		{"grew and shrank", map[string]int64{"amd64": 24, "386": 8}, map[string]int64{"amd64": 16, "386": 12}, CHANGE_GREW},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldReports, newReports := archReports{}, archReports{}
+
+			for _, arch := range []string{"amd64", "386"} {
+				oldReports[arch] = getTestSizesReport(map[string]int64{})
+				newReports[arch] = getTestSizesReport(map[string]int64{})
+
+				if size, ok := tt.old[arch]; ok {
+					oldReports[arch] = getTestSizesReport(map[string]int64{"Data": size})
+				}
+
+				if size, ok := tt.new[arch]; ok {
+					newReports[arch] = getTestSizesReport(map[string]int64{"Data": size})
+				}
+			}
+
+			// Arch which is present only in one report must be ignored
+			newReports["arm64"] = getTestSizesReport(map[string]int64{"Data": 64})
+
+			changes := compareReports(oldReports, newReports)
+
+			if tt.status == "" {
+				if len(changes) != 0 {
+					t.Errorf("got changes %v, want none", formatTestChanges(changes))
+				}
+
+				return
+			}
+
+			if len(changes) != 1 {
+				t.Fatalf("got changes %v, want one change", formatTestChanges(changes))
+			}
+
+			if changes[0].Status != tt.status {
+				t.Errorf("got status %q, want %q", changes[0].Status, tt.status)
+			}
+
+			for i, arch := range []string{"386", "amd64"} {
+				s := changes[0].Sizes[i]
+
+				if s.Arch != arch || s.OldSize != tt.old[arch] || s.NewSize != tt.new[arch] || s.Delta != s.NewSize-s.OldSize {
+					t.Errorf("got %s size change %d→%d (%d), want %d→%d", s.Arch, s.OldSize, s.NewSize, s.Delta, tt.old[arch], tt.new[arch])
+				}
+			}
+		})
+	}
+}
+
+func TestReadArchReport(t *testing.T) {
+	tests := []struct {
+		name string
+		meta *report.Meta
+		str  *report.Struct
+		want map[string]int64
+	}{
+		{
+			"multiple archs",
+			&report.Meta{Arch: "amd64", Archs: []string{"amd64", "386"}},
+			&report.Struct{Name: "Data", Size: 24, ArchSizes: map[string]int64{"amd64": 24, "386": 16}},
+			map[string]int64{"amd64": 24, "386": 16},
+		},
+		{
+			"single arch",
+			&report.Meta{Arch: "arm64", Archs: []string{"arm64"}},
+			&report.Struct{Name: "Data", Size: 24, ArchSizes: map[string]int64{"arm64": 24}},
+			map[string]int64{"arm64": 24},
+		},
+		{
+			"report without arch sizes",
+			&report.Meta{Arch: "arm64"},
+			&report.Struct{Name: "Data", Size: 32},
+			map[string]int64{"arm64": 32},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "report.json")
+			data, _ := json.Marshal(&report.Report{
+				Meta: tt.meta,
+				Packages: []*report.Package{
+					{Path: "example.com/test", Structs: []*report.Struct{tt.str}},
+				},
+			})
+
+			if err := os.WriteFile(file, data, 0644); err != nil {
+				t.Fatalf("can't write report: %v", err)
+			}
+
+			reports, err := readArchReport(file)
+
+			if err != nil {
+				t.Fatalf("can't read report: %v", err)
+			}
+
+			got := map[string]int64{}
+
+			for arch, r := range reports {
+				for key, size := range getStructSizes(r) {
+					if key == "example.com/test.Data" {
+						got[arch] = size
+					}
+				}
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got sizes %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProcessRevision(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	defer func(sizes types.Sizes, archs []string) {
+		inspect.Sizes, inspect.Archs = sizes, archs
+	}(inspect.Sizes, inspect.Archs)
+
+	dir := t.TempDir()
+
+	createTestRepo(t, dir, testCompareOld, testCompareNew)
+
+	t.Chdir(dir)
+
+	inspect.Sizes = types.SizesFor("gc", "amd64")
+	inspect.Archs = []string{"amd64", "386"}
+
+	oldReports, err := processRevision("v1", []string{"./..."})
+
+	if err != nil {
+		t.Fatalf("can't process revision: %v", err)
+	}
+
+	newReports, err := processRevision("v2", []string{"./..."})
+
+	if err != nil {
+		t.Fatalf("can't process revision: %v", err)
+	}
+
+	want := []string{
+		"example.com/test.Added added 386:0→8 amd64:0→16",
+		"example.com/test.Grown grew 386:4→8 amd64:4→16",
+		"example.com/test.Removed removed 386:8→0 amd64:8→0",
+		"example.com/test.Shrunk shrank 386:16→12 amd64:24→16",
+	}
+
+	got := formatTestChanges(compareReports(oldReports, newReports))
+
+	if !slices.Equal(got, want) {
+		t.Errorf("got changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getTestSizesReport returns report with structs with given sizes
+func getTestSizesReport(sizes map[string]int64) *report.Report {
+	pkg := &report.Package{Path: "example.com/test"}
+
+	for name, size := range sizes {
+		pkg.Structs = append(pkg.Structs, &report.Struct{Name: name, Size: size})
+	}
+
+	return &report.Report{Packages: []*report.Package{pkg}}
+}
+
+// createTestRepo creates git repository with commit tagged v1, v2, … for every
+// given version of source file
+func createTestRepo(t *testing.T, dir string, sources ...string) {
+	err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/test\n\ngo 1.23\n"), 0644)
+
+	if err == nil {
+		_, err = runGit(dir, "init", "-q")
+	}
+
+	for i, src := range sources {
+		tag := fmt.Sprintf("v%d", i+1)
+
+		if err == nil {
+			err = os.WriteFile(filepath.Join(dir, "test.go"), []byte(src), 0644)
+		}
+
+		for _, args := range [][]string{
+			{"add", "-A"},
+			{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", tag},
+			{"tag", tag},
+		} {
+			if err == nil {
+				_, err = runGit(dir, args...)
+			}
+		}
+	}
+
+	if err != nil {
+		t.Fatalf("can't create repository: %v", err)
+	}
+}
+
+// formatTestChanges formats changes for comparison
+func formatTestChanges(changes []*StructChange) []string {
+	var result []string
+
+	for _, c := range changes {
+		text := c.Struct + " " + c.Status
+
+		for _, s := range c.Sizes {
+			text += fmt.Sprintf(" %s:%d→%d", s.Arch, s.OldSize, s.NewSize)
+		}
+
+		result = append(result, text)
+	}
+
+	return result
+}
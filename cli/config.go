@@ -0,0 +1,407 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/essentialkaos/ek/v14/options"
+	"github.com/essentialkaos/ek/v14/strutil"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configFiles is a list of supported configuration file names
+var configFiles = []string{CONFIG_FILE, ".aligo.yaml", ".aligo.toml"}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Config contains configuration from configuration file
+type Config struct {
+	Format          string           `yaml:"format" toml:"format"`
+	Baseline        string           `yaml:"baseline" toml:"baseline"`
+	Lock            string           `yaml:"lock" toml:"lock"`
+	Paths           []string         `yaml:"paths" toml:"paths"`
+	Include         []string         `yaml:"include" toml:"include"`
+	Exclude         []string         `yaml:"exclude" toml:"exclude"`
+	IncludeStructs  []string         `yaml:"include-structs" toml:"include-structs"`
+	ExcludeStructs  []string         `yaml:"exclude-structs" toml:"exclude-structs"`
+	Tags            []string         `yaml:"tags" toml:"tags"`
+	Arch            []string         `yaml:"arch" toml:"arch"`
+	Ignore          []string         `yaml:"ignore" toml:"ignore"`
+	Packages        []*PackageConfig `yaml:"packages" toml:"packages"`
+	MinWaste        int64            `yaml:"min-waste" toml:"min-waste"`
+	Threshold       int64            `yaml:"threshold" toml:"threshold"`
+	IncludeABI      bool             `yaml:"include-abi" toml:"include-abi"`
+	KeepTaggedOrder bool             `yaml:"keep-tagged-order" toml:"keep-tagged-order"`
+	IncludeGen      bool             `yaml:"include-generated" toml:"include-generated"`
+}
+
+// PackageConfig contains configuration overrides for packages
+type PackageConfig struct {
+	MinWaste *int64   `yaml:"min-waste" toml:"min-waste"`
+	Path     string   `yaml:"path" toml:"path"` // import path or pattern with "/..." suffix
+	Ignore   []string `yaml:"ignore" toml:"ignore"`
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// config is configuration from configuration file
+var config = &Config{}
+
+// filter is filter for packages, files and structs
+var filter *inspect.Filter
+
+// structSelectors contains selectors of structs defined with --struct option
+var structSelectors []*structSelector
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// loadConfig finds configuration file in current directory or any of its
+// parents and reads it
+func loadConfig() error {
+	file := findConfig()
+
+	if file == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(file)
+
+	if err != nil {
+		return i18n.UI.ERRORS.CANT_READ_CONFIG.Error(file, err)
+	}
+
+	if strings.HasSuffix(file, ".toml") {
+		err = decodeTOMLConfig(file, data)
+	} else {
+		err = decodeYAMLConfig(file, data)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	config.Paths = resolveConfigPaths(filepath.Dir(file), config.Paths)
+
+	if config.Baseline != "" && !filepath.IsAbs(config.Baseline) {
+		config.Baseline = resolveConfigFile(filepath.Dir(file), config.Baseline)
+	}
+
+	if config.Lock != "" && !filepath.IsAbs(config.Lock) {
+		config.Lock = resolveConfigFile(filepath.Dir(file), config.Lock)
+	}
+
+	return nil
+}
+
+// decodeYAMLConfig decodes configuration in YAML format and checks it
+// for unknown keys
+func decodeYAMLConfig(file string, data []byte) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(config)
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		return i18n.UI.ERRORS.CANT_READ_CONFIG.Error(file, err)
+	}
+
+	return nil
+}
+
+// decodeTOMLConfig decodes configuration in TOML format and checks it
+// for unknown keys
+func decodeTOMLConfig(file string, data []byte) error {
+	meta, err := toml.Decode(string(data), config)
+
+	if err != nil {
+		return i18n.UI.ERRORS.CANT_READ_CONFIG.Error(file, err)
+	}
+
+	if len(meta.Undecoded()) != 0 {
+		var keys []string
+
+		for _, key := range meta.Undecoded() {
+			keys = append(keys, key.String())
+		}
+
+		return i18n.UI.ERRORS.UNKNOWN_CONFIG_KEYS.Error(file, strings.Join(keys, ", "))
+	}
+
+	return nil
+}
+
+// findConfig returns path to the nearest configuration file
+func findConfig() string {
+	dir, err := os.Getwd()
+
+	if err != nil {
+		return ""
+	}
+
+	for {
+		for _, name := range configFiles {
+			file := filepath.Join(dir, name)
+
+			if _, err := os.Stat(file); err == nil {
+				return file
+			}
+		}
+
+		if filepath.Dir(dir) == dir {
+			return ""
+		}
+
+		dir = filepath.Dir(dir)
+	}
+}
+
+// resolveConfigPaths converts paths relative to configuration file to paths
+// relative to current directory
+func resolveConfigPaths(configDir string, paths []string) []string {
+	cwd, err := os.Getwd()
+
+	if err != nil {
+		return paths
+	}
+
+	var result []string
+
+	for _, path := range paths {
+		if !filepath.IsAbs(path) && (path == "." || strings.HasPrefix(path, "./") || strings.HasPrefix(path, "../")) {
+			relPath, err := filepath.Rel(cwd, filepath.Join(configDir, path))
+
+			if err == nil {
+				path = "./" + filepath.ToSlash(relPath)
+
+				if strings.HasSuffix(path, "/...") && strings.HasPrefix(path, "./../") {
+					path = strings.TrimPrefix(path, "./")
+				}
+			}
+		}
+
+		result = append(result, path)
+	}
+
+	return result
+}
+
+// resolveConfigFile converts path to file relative to configuration file to
+// path relative to current directory
+func resolveConfigFile(configDir, file string) string {
+	cwd, err := os.Getwd()
+
+	if err != nil {
+		return file
+	}
+
+	relPath, err := filepath.Rel(cwd, filepath.Join(configDir, file))
+
+	if err != nil {
+		return file
+	}
+
+	return relPath
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// applyConfig marks structs ignored in configuration file and sets minimal
+// waste thresholds
+func applyConfig(r *report.Report) {
+	for _, pkg := range r.Packages {
+		ignored := config.Ignore
+		minWaste := config.MinWaste
+
+		for _, pkgConfig := range config.Packages {
+			if !matchPackage(pkgConfig.Path, pkg.Path) {
+				continue
+			}
+
+			ignored = append(slices.Clone(ignored), pkgConfig.Ignore...)
+
+			if pkgConfig.MinWaste != nil {
+				minWaste = *pkgConfig.MinWaste
+			}
+		}
+
+		for _, str := range pkg.Structs {
+			if slices.Contains(ignored, str.Name) || slices.Contains(ignored, pkg.Path+"."+str.Name) {
+				str.Ignore = true
+			}
+
+			str.MinWaste = minWaste
+		}
+	}
+}
+
+// matchPackage returns true if package path matches given pattern
+func matchPackage(pattern, pkgPath string) bool {
+	if strings.HasSuffix(pattern, "/...") {
+		prefix := strings.TrimSuffix(pattern, "/...")
+		return pkgPath == prefix || strings.HasPrefix(pkgPath, prefix+"/")
+	}
+
+	return pattern == pkgPath
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getFlag returns value of boolean option which can be enabled or disabled
+// with negation option, or value from configuration
+func getFlag(opt, negOpt string, value bool) bool {
+	switch {
+	case options.GetB(negOpt):
+		return false
+	case options.GetB(opt):
+		return true
+	}
+
+	return value
+}
+
+// getPaths returns list of paths from command arguments or configuration
+func getPaths(args options.Arguments) []string {
+	if len(args) < 2 {
+		return config.Paths
+	}
+
+	return args.Strings()[1:]
+}
+
+// getBaselineFile returns path to baseline file from options or configuration
+func getBaselineFile() string {
+	switch {
+	case options.Has(OPT_BASELINE):
+		return options.GetS(OPT_BASELINE)
+	case config.Baseline != "":
+		return config.Baseline
+	}
+
+	return DEFAULT_BASELINE
+}
+
+// getLockFile returns path to lock file from options or configuration
+func getLockFile() string {
+	switch {
+	case options.Has(OPT_LOCK_FILE):
+		return options.GetS(OPT_LOCK_FILE)
+	case config.Lock != "":
+		return config.Lock
+	}
+
+	return DEFAULT_LOCK
+}
+
+// getFormat returns output format from options or configuration
+func getFormat() string {
+	if !options.Has(OPT_FORMAT) && config.Format != "" {
+		return strings.ToLower(config.Format)
+	}
+
+	return strings.ToLower(options.GetS(OPT_FORMAT))
+}
+
+// getArchs returns list of archs from options or configuration
+func getArchs() []string {
+	if !options.Has(OPT_ARCH) && len(config.Arch) != 0 {
+		return config.Arch
+	}
+
+	return strutil.Fields(options.GetS(OPT_ARCH))
+}
+
+// getTags returns list of build tags from options or configuration
+func getTags() []string {
+	if !options.Has(OPT_TAGS) {
+		return config.Tags
+	}
+
+	return strutil.Fields(options.GetS(OPT_TAGS))
+}
+
+// getExcludes returns list of excluded import paths and files from options
+// or configuration
+func getExcludes() []string {
+	return getPatterns(OPT_EXCLUDE, config.Exclude)
+}
+
+// getFilter creates filter for packages, files and structs from options
+// or configuration
+func getFilter() (*inspect.Filter, error) {
+	filter := &inspect.Filter{
+		Include: getPatterns(OPT_INCLUDE, config.Include),
+		Exclude: getExcludes(),
+	}
+
+	var err error
+
+	filter.IncludeStructs, err = compileRegexps(getPatterns(OPT_INCL_STRUCT, config.IncludeStructs))
+
+	if err != nil {
+		return nil, err
+	}
+
+	filter.ExcludeStructs, err = compileRegexps(getPatterns(OPT_EXCL_STRUCT, config.ExcludeStructs))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// getPatterns returns list of patterns from option or configuration
+func getPatterns(opt string, configPatterns []string) []string {
+	if !options.Has(opt) {
+		return configPatterns
+	}
+
+	return strutil.Fields(options.GetS(opt))
+}
+
+// compileRegexps compiles given regular expressions
+func compileRegexps(patterns []string) ([]*regexp.Regexp, error) {
+	var result []*regexp.Regexp
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+
+		if err != nil {
+			return nil, i18n.UI.ERRORS.INVALID_REGEXP.Error(pattern, err)
+		}
+
+		result = append(result, re)
+	}
+
+	return result, nil
+}
+
+// getThreshold returns maximum allowed struct growth from options or configuration
+func getThreshold() int64 {
+	if !options.Has(OPT_THRESHOLD) {
+		return config.Threshold
+	}
+
+	return int64(options.GetI(OPT_THRESHOLD))
+}
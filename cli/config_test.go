@@ -0,0 +1,194 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/essentialkaos/ek/v14/options"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// testArgs is command line used by all tests in package
+var testArgs = []string{
+	"--arch", "386", "--tags", "integration",
+	"--no-include-abi", "--include-generated", "check", ".",
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestMain(m *testing.M) {
+	args := os.Args
+	os.Args = append([]string{APP}, testArgs...)
+	_, errs := options.Parse(optMap)
+	os.Args = args
+
+	if !errs.IsEmpty() {
+		panic(errs.Error())
+	}
+
+	os.Exit(m.Run())
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestConfigPrecedence(t *testing.T) {
+	defer func() { config = &Config{} }()
+
+	config = &Config{
+		Format:     "JSON",
+		Arch:       []string{"arm64"},
+		Tags:       []string{"unit"},
+		Exclude:    []string{"**/mocks/**"},
+		Threshold:  16,
+		IncludeABI: true,
+		IncludeGen: false,
+
+		KeepTaggedOrder: true,
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"format from config", getFormat(), "json"},
+		{"arch from options", getArchs(), []string{"386"}},
+		{"tags from options", getTags(), []string{"integration"}},
+		{"excludes from config", getExcludes(), []string{"**/mocks/**"}},
+		{"threshold from config", getThreshold(), int64(16)},
+		{"flag disabled by negation option", getFlag(OPT_INCLUDE_ABI, OPT_NO_ABI, config.IncludeABI), false},
+		{"flag enabled by option", getFlag(OPT_INCLUDE_GEN, OPT_NO_GEN, config.IncludeGen), true},
+		{"flag from config", getFlag(OPT_KEEP_ORDER, OPT_NO_ORDER, config.KeepTaggedOrder), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !equal(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigDecoding(t *testing.T) {
+	defer func() { config = &Config{} }()
+
+	tests := []struct {
+		name string
+		file string
+		data string
+		err  string
+	}{
+		{"yaml", ".aligo.yml", "paths: [./...]\nmin-waste: 8\n", ""},
+		{"empty yaml", ".aligo.yml", "", ""},
+		{"yaml with unknown key", ".aligo.yml", "paths: [./...]\nmin-wast: 8\n", "field min-wast not found"},
+		{"yaml with unknown nested key", ".aligo.yml", "packages:\n  - path: x\n    foo: 1\n", "field foo not found"},
+		{"toml", ".aligo.toml", "paths = [\"./...\"]\nmin-waste = 8\n", ""},
+		{"toml with unknown keys", ".aligo.toml", "foo = 1\n[[packages]]\npath = \"x\"\nbar = 2\n", "unknown keys: foo, packages.bar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+
+			config = &Config{}
+
+			if strings.HasSuffix(tt.file, ".toml") {
+				err = decodeTOMLConfig(tt.file, []byte(tt.data))
+			} else {
+				err = decodeYAMLConfig(tt.file, []byte(tt.data))
+			}
+
+			switch {
+			case tt.err == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+				t.Errorf("got error %v, want error containing %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	defer func() { config = &Config{} }()
+
+	minWaste := int64(16)
+	config = &Config{
+		MinWaste: 4,
+		Ignore:   []string{"Global", "example.com/a.Qualified"},
+		Packages: []*PackageConfig{
+			{Path: "example.com/a/...", MinWaste: &minWaste, Ignore: []string{"Local"}},
+		},
+	}
+
+	r := &report.Report{Packages: []*report.Package{
+		{Path: "example.com/a", Structs: []*report.Struct{{Name: "Global"}, {Name: "Qualified"}, {Name: "Local"}, {Name: "Other"}}},
+		{Path: "example.com/a/b", Structs: []*report.Struct{{Name: "Local"}}},
+		{Path: "example.com/c", Structs: []*report.Struct{{Name: "Qualified"}, {Name: "Local"}}},
+	}}
+
+	applyConfig(r)
+
+	tests := []struct {
+		pkg      int
+		str      int
+		ignore   bool
+		minWaste int64
+	}{
+		{0, 0, true, 16},
+		{0, 1, true, 16},
+		{0, 2, true, 16},
+		{0, 3, false, 16},
+		{1, 0, true, 16},
+		{2, 0, false, 4},
+		{2, 1, false, 4},
+	}
+
+	for _, tt := range tests {
+		str := r.Packages[tt.pkg].Structs[tt.str]
+
+		if str.Ignore != tt.ignore || str.MinWaste != tt.minWaste {
+			t.Errorf(
+				"%s.%s: got ignore=%t min-waste=%d, want ignore=%t min-waste=%d",
+				r.Packages[tt.pkg].Path, str.Name, str.Ignore, str.MinWaste, tt.ignore, tt.minWaste,
+			)
+		}
+	}
+
+	data, err := json.Marshal(r)
+
+	if err != nil {
+		t.Fatalf("can't encode report: %v", err)
+	}
+
+	if strings.Contains(string(data), "min_waste") {
+		t.Errorf("minimal waste from config is saved in report")
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// equal compares values used in table tests
+func equal(got, want any) bool {
+	g, ok1 := got.([]string)
+	w, ok2 := want.([]string)
+
+	if ok1 && ok2 {
+		return slices.Equal(g, w)
+	}
+
+	return got == want
+}
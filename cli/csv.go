@@ -0,0 +1,74 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printCSV prints report as table with one row per struct or per field
+// if perField is true
+func printCSV(r *report.Report, root string, separator rune, perField bool) error {
+	w := csv.NewWriter(os.Stdout)
+	w.Comma = separator
+
+	if perField {
+		w.Write([]string{
+			"package", "struct", "file", "line", "field", "type",
+			"offset", "size", "padding",
+		})
+	} else {
+		w.Write([]string{
+			"package", "struct", "file", "line", "size", "optimal_size",
+			"wasted", "fields", "abi", "ignore",
+		})
+	}
+
+	for _, pkg := range r.Packages {
+		for _, str := range pkg.Structs {
+			file := getRelativePath(root, str.Position.Path)
+			line := fmt.Sprint(str.Position.Line)
+
+			if !perField {
+				var wasted int64
+
+				// Ignored, ABI and structs with small waste are not reported,
+				// so their padding is not counted as wasted
+				if !isAlignedStruct(str) {
+					wasted = str.Size - str.OptimalSize
+				}
+
+				w.Write([]string{
+					pkg.Path, str.Name, file, line,
+					fmt.Sprint(str.Size), fmt.Sprint(str.OptimalSize),
+					fmt.Sprint(wasted), fmt.Sprint(len(str.Fields)),
+					fmt.Sprint(str.ABI), fmt.Sprint(str.Ignore),
+				})
+
+				continue
+			}
+
+			for _, f := range str.Fields {
+				w.Write([]string{
+					pkg.Path, str.Name, file, line, f.Name, f.Type,
+					fmt.Sprint(f.Offset), fmt.Sprint(f.Size), fmt.Sprint(f.Padding),
+				})
+			}
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
@@ -0,0 +1,79 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestPrintCSV(t *testing.T) {
+	r := getTestReport("/src/module")
+	pkg := r.Packages[0]
+
+	pkg.Structs = append(pkg.Structs[:1],
+		&report.Struct{Name: "Ignored", Size: 24, OptimalSize: 16, Ignore: true},
+		&report.Struct{Name: "Cgo", Size: 24, OptimalSize: 16, ABI: true},
+		&report.Struct{Name: "Small", Size: 24, OptimalSize: 16, MinWaste: 16},
+	)
+
+	r.Packages = append(r.Packages, &report.Package{
+		Path: "example.com/a,b",
+		Structs: []*report.Struct{{
+			Name:     "Quoted",
+			Position: report.Position{Path: "/src/module/a,b/types.go", Line: 5, Column: 1},
+			Fields:   []*report.Field{{Name: "A", Type: `struct{ B int "json:\"b\"" }`, Size: 8}},
+			Size:     8, OptimalSize: 8,
+		}},
+	})
+
+	tests := []struct {
+		name      string
+		separator rune
+		perField  bool
+		want      string
+	}{
+		{
+			"structs", ',', false,
+			"package,struct,file,line,size,optimal_size,wasted,fields,abi,ignore\n" +
+				"example.com/test/api,Request,api/types.go,3,24,16,8,3,false,false\n" +
+				"example.com/test/api,Ignored,,0,24,16,0,0,false,true\n" +
+				"example.com/test/api,Cgo,,0,24,16,0,0,true,false\n" +
+				"example.com/test/api,Small,,0,24,16,0,0,false,false\n" +
+				"\"example.com/a,b\",Quoted,\"a,b/types.go\",5,8,8,0,1,false,false\n",
+		},
+		{
+			"structs with custom separator", ';', false,
+			"package;struct;file;line;size;optimal_size;wasted;fields;abi;ignore\n" +
+				"example.com/test/api;Request;api/types.go;3;24;16;8;3;false;false\n" +
+				"example.com/test/api;Ignored;;0;24;16;0;0;false;true\n" +
+				"example.com/test/api;Cgo;;0;24;16;0;0;true;false\n" +
+				"example.com/test/api;Small;;0;24;16;0;0;false;false\n" +
+				"example.com/a,b;Quoted;a,b/types.go;5;8;8;0;1;false;false\n",
+		},
+		{
+			"fields", ',', true,
+			"package,struct,file,line,field,type,offset,size,padding\n" +
+				"example.com/test/api,Request,api/types.go,3,Ready,bool,0,1,7\n" +
+				"example.com/test/api,Request,api/types.go,3,Count,int64,8,8,0\n" +
+				"example.com/test/api,Request,api/types.go,3,Ch,chan<- bool,16,8,0\n" +
+				"\"example.com/a,b\",Quoted,\"a,b/types.go\",5,A,\"struct{ B int \"\"json:\\\"\"b\\\"\"\"\" }\",0,8,0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		out := captureOutput(t, func() error { return printCSV(r, "/src/module", tt.separator, tt.perField) })
+
+		if out != tt.want {
+			t.Errorf("%s: got output:\n%s\nwant:\n%s", tt.name, out, tt.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// githubDataEscaper escapes data of workflow command
+var githubDataEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+
+// githubPropEscaper escapes properties of workflow command
+var githubPropEscaper = strings.NewReplacer(
+	"%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C",
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printGitHub prints problems from report as GitHub Actions workflow commands
+// and writes step summary if GITHUB_STEP_SUMMARY is set
+func printGitHub(r *report.Report, root string) error {
+	for _, p := range getProblems(r) {
+		fmt.Printf(
+			"::warning file=%s,line=%d,col=%d,title=%s::%s\n",
+			githubPropEscaper.Replace(getGitHubPath(root, p.Struct.Position.Path)),
+			p.Struct.Position.Line, p.Struct.Position.Column,
+			githubPropEscaper.Replace(APP+": "+p.Rule),
+			githubDataEscaper.Replace(p.Message),
+		)
+	}
+
+	summaryFile := os.Getenv("GITHUB_STEP_SUMMARY")
+
+	if summaryFile == "" {
+		return nil
+	}
+
+	fd, err := os.OpenFile(summaryFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+
+	if err != nil {
+		return err
+	}
+
+	defer fd.Close()
+
+	_, err = fd.WriteString(getGitHubSummary(r))
+
+	return err
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getGitHubSummary returns Markdown table with wasted bytes per package
+func getGitHubSummary(r *report.Report) string {
+	var buf strings.Builder
+	var totalStructs int
+	var totalWasted int64
+
+	buf.WriteString("### " + APP + "\n\n")
+
+	for _, pkg := range r.Packages {
+		var structs int
+		var wasted int64
+
+		for _, str := range pkg.Structs {
+			if !isAlignedStruct(str) {
+				structs++
+				wasted += str.Size - str.OptimalSize
+			}
+		}
+
+		if structs == 0 {
+			continue
+		}
+
+		if totalStructs == 0 {
+			buf.WriteString("| Package | Structs | Wasted bytes |\n")
+			buf.WriteString("|---------|--------:|-------------:|\n")
+		}
+
+		fmt.Fprintf(&buf, "| `%s` | %d | %d |\n", pkg.Path, structs, wasted)
+
+		totalStructs += structs
+		totalWasted += wasted
+	}
+
+	if totalStructs == 0 {
+		buf.WriteString("All structs are well aligned\n\n")
+		return buf.String()
+	}
+
+	fmt.Fprintf(&buf, "| **Total** | **%d** | **%d** |\n\n", totalStructs, totalWasted)
+
+	return buf.String()
+}
+
+// getGitHubPath returns path to file relative to the workspace
+func getGitHubPath(root, path string) string {
+	workspace := os.Getenv("GITHUB_WORKSPACE")
+
+	if workspace == "" {
+		return getRelativePath(root, path)
+	}
+
+	relPath, err := filepath.Rel(workspace, path)
+
+	if err != nil || strings.HasPrefix(relPath, "..") {
+		return getRelativePath(root, path)
+	}
+
+	return filepath.ToSlash(relPath)
+}
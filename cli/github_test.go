@@ -0,0 +1,109 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestPrintGitHub(t *testing.T) {
+	budget := getTestReport("/src/module")
+	budget.Packages[0].Structs = budget.Packages[0].Structs[1:2]
+	budget.Packages[0].Structs[0].Warnings = []*report.Warning{
+		{Type: report.WARN_BUDGET_ARCH, Arch: "50%\nx86"},
+	}
+
+	tests := []struct {
+		name      string
+		r         *report.Report
+		root      string
+		workspace string
+		want      string
+	}{
+		{
+			"module root",
+			getTestReport("/src/module"), "/src/module", "",
+			"::warning file=api/types.go,line=3,col=1,title=aligo%3A alignment::Struct Request fields order can be optimized (24 → 16)\n" +
+				"::warning file=api/types.go,line=9,col=1,title=aligo%3A max-size::Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B\n",
+		},
+		{
+			"workspace",
+			getTestReport("/src/module"), "/src/module/api", "/src",
+			"::warning file=module/api/types.go,line=3,col=1,title=aligo%3A alignment::Struct Request fields order can be optimized (24 → 16)\n" +
+				"::warning file=module/api/types.go,line=9,col=1,title=aligo%3A max-size::Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B\n",
+		},
+		{
+			"file outside of workspace",
+			getTestReport("/src/module"), "/src/module", "/home/runner/work",
+			"::warning file=api/types.go,line=3,col=1,title=aligo%3A alignment::Struct Request fields order can be optimized (24 → 16)\n" +
+				"::warning file=api/types.go,line=9,col=1,title=aligo%3A max-size::Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B\n",
+		},
+		{
+			"escaped properties",
+			getTestReport("/src/a,b:c"), "", "",
+			"::warning file=/src/a%2Cb%3Ac/api/types.go,line=3,col=1,title=aligo%3A alignment::Struct Request fields order can be optimized (24 → 16)\n" +
+				"::warning file=/src/a%2Cb%3Ac/api/types.go,line=9,col=1,title=aligo%3A max-size::Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B\n",
+		},
+		{
+			"escaped message",
+			budget, "/src/module", "",
+			"::warning file=api/types.go,line=9,col=1,title=aligo%3A budget-arch::Struct Limited: Unknown architecture 50%25%0Ax86 in size budget directive\n",
+		},
+		{
+			"no problems",
+			&report.Report{Packages: []*report.Package{{Path: "example.com/test"}}}, "/src/module", "",
+			"",
+		},
+	}
+
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+
+	for _, tt := range tests {
+		t.Setenv("GITHUB_WORKSPACE", tt.workspace)
+
+		out := captureOutput(t, func() error { return printGitHub(tt.r, tt.root) })
+
+		if out != tt.want {
+			t.Errorf("%s: got output:\n%s\nwant:\n%s", tt.name, out, tt.want)
+		}
+	}
+}
+
+func TestGitHubSummary(t *testing.T) {
+	summaryFile := filepath.Join(t.TempDir(), "summary.md")
+
+	t.Setenv("GITHUB_WORKSPACE", "")
+	t.Setenv("GITHUB_STEP_SUMMARY", summaryFile)
+
+	captureOutput(t, func() error { return printGitHub(getTestReport("/src/module"), "/src/module") })
+	captureOutput(t, func() error { return printGitHub(&report.Report{}, "/src/module") })
+
+	data, err := os.ReadFile(summaryFile)
+
+	if err != nil {
+		t.Fatalf("can't read summary: %v", err)
+	}
+
+	want := "### aligo\n\n" +
+		"| Package | Structs | Wasted bytes |\n" +
+		"|---------|--------:|-------------:|\n" +
+		"| `example.com/test/api` | 1 | 8 |\n" +
+		"| **Total** | **1** | **8** |\n\n" +
+		"### aligo\n\n" +
+		"All structs are well aligned\n\n"
+
+	if string(data) != want {
+		t.Errorf("got summary:\n%s\nwant:\n%s", data, want)
+	}
+}
@@ -0,0 +1,106 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	_ "embed"
+	"html/template"
+	"os"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// htmlReport contains data for HTML report template
+type htmlReport struct {
+	Meta     *report.Meta   `json:"meta"`
+	Packages []*htmlPackage `json:"packages"`
+}
+
+// htmlPackage contains info about package for HTML report
+type htmlPackage struct {
+	Path    string        `json:"path"`
+	Structs []*htmlStruct `json:"structs"`
+}
+
+// htmlStruct contains info about struct for HTML report
+type htmlStruct struct {
+	*report.Struct
+
+	File     string   `json:"file"`
+	Messages []string `json:"messages"`
+	Index    int      `json:"index"`
+	Wasted   int64    `json:"wasted"`
+	Problem  bool     `json:"problem"`
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+//go:embed templates/report.html
+var htmlTemplate string
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printHTML prints report as self-contained HTML page
+func printHTML(r *report.Report, root string) error {
+	tmpl, err := template.New("report").Parse(htmlTemplate)
+
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(os.Stdout, map[string]any{
+		"Title": APP + " report",
+		"Data":  getHTMLReport(r, root),
+	})
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getHTMLReport converts report to HTML report data
+func getHTMLReport(r *report.Report, root string) *htmlReport {
+	result := &htmlReport{Meta: r.Meta, Packages: []*htmlPackage{}}
+	messages := map[*report.Struct][]string{}
+
+	for _, p := range getProblems(r) {
+		messages[p.Struct] = append(messages[p.Struct], p.Message)
+	}
+
+	if result.Meta == nil {
+		result.Meta = &report.Meta{MaxAlign: 8}
+	}
+
+	for _, pkg := range r.Packages {
+		if pkg.IsEmpty() {
+			continue
+		}
+
+		p := &htmlPackage{Path: pkg.Path}
+
+		for index, str := range pkg.Structs {
+			s := &htmlStruct{
+				Struct:   str,
+				File:     getRelativePath(root, str.Position.Path),
+				Messages: messages[str],
+				Index:    index,
+				Problem:  isProblemStruct(str),
+			}
+
+			if !isAlignedStruct(str) {
+				s.Wasted = str.Size - str.OptimalSize
+			}
+
+			p.Structs = append(p.Structs, s)
+		}
+
+		result.Packages = append(result.Packages, p)
+	}
+
+	return result
+}
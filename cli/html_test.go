@@ -0,0 +1,92 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestPrintHTML(t *testing.T) {
+	r := getTestReport("/src/module")
+	r.Packages[0].Structs[0].Fields[2].Comment = "</script><script>alert(1)</script>"
+
+	out := captureOutput(t, func() error { return printHTML(r, "/src/module") })
+
+	if !strings.Contains(out, "<title>aligo report</title>") {
+		t.Errorf("report has no title")
+	}
+
+	if strings.Count(out, "</script>") != strings.Count(htmlTemplate, "</script>") {
+		t.Errorf("data is not escaped in script element")
+	}
+
+	if strings.Contains(htmlTemplate, "innerHTML") {
+		t.Errorf("template renders data as HTML")
+	}
+
+	_, data, ok := strings.Cut(out, "const REPORT = ")
+
+	if !ok {
+		t.Fatalf("report has no data")
+	}
+
+	data, _, _ = strings.Cut(data, ";\n")
+
+	if strings.ContainsAny(data, "<>&") {
+		t.Errorf("data contains unescaped characters: %s", data)
+	}
+
+	var result map[string]any
+
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		t.Fatalf("can't decode report data: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		path []any
+		want any
+	}{
+		{"tool", []any{"meta", "tool"}, APP},
+		{"arch", []any{"meta", "arch"}, "amd64"},
+		{"max align", []any{"meta", "max_align"}, 8.0},
+		{"packages", []any{"packages", "#"}, 1.0},
+		{"package path", []any{"packages", 0, "path"}, "example.com/test/api"},
+		{"structs", []any{"packages", 0, "structs", "#"}, 3.0},
+
+		{"padded name", []any{"packages", 0, "structs", 0, "name"}, "Request"},
+		{"padded file", []any{"packages", 0, "structs", 0, "file"}, "api/types.go"},
+		{"padded line", []any{"packages", 0, "structs", 0, "position", "line"}, 3.0},
+		{"padded size", []any{"packages", 0, "structs", 0, "size"}, 24.0},
+		{"padded optimal size", []any{"packages", 0, "structs", 0, "optimal_size"}, 16.0},
+		{"padded wasted", []any{"packages", 0, "structs", 0, "wasted"}, 8.0},
+		{"padded problem", []any{"packages", 0, "structs", 0, "problem"}, true},
+		{"padded message", []any{"packages", 0, "structs", 0, "messages", 0}, "Struct Request fields order can be optimized (24 → 16)"},
+		{"field type", []any{"packages", 0, "structs", 0, "fields", 2, "type"}, "chan<- bool"},
+		{"field comment", []any{"packages", 0, "structs", 0, "fields", 2, "comment"}, "</script><script>alert(1)</script>"},
+		{"field tag", []any{"packages", 0, "structs", 0, "fields", 0, "tag"}, `json:"ready"`},
+		{"field padding", []any{"packages", 0, "structs", 0, "fields", 0, "padding"}, 7.0},
+
+		{"budget wasted", []any{"packages", 0, "structs", 1, "wasted"}, 0.0},
+		{"budget message", []any{"packages", 0, "structs", 1, "messages", 0}, "Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B"},
+
+		{"aligned index", []any{"packages", 0, "structs", 2, "index"}, 2.0},
+		{"aligned problem", []any{"packages", 0, "structs", 2, "problem"}, false},
+		{"aligned messages", []any{"packages", 0, "structs", 2, "messages"}, nil},
+	}
+
+	for _, tt := range tests {
+		if got := getJSONValue(result, tt.path); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
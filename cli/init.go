@@ -0,0 +1,291 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/essentialkaos/ek/v14/fmtc"
+	"github.com/essentialkaos/ek/v14/fmtutil"
+	"github.com/essentialkaos/ek/v14/options"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// CONFIG_FILE is name of configuration file created by init command
+const CONFIG_FILE = ".aligo.yml"
+
+// WORKFLOW_FILE is path to GitHub Actions workflow created by init command
+const WORKFLOW_FILE = ".github/workflows/aligo.yml"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// workflowTemplate is template of GitHub Actions workflow
+const workflowTemplate = `name: Aligo
+
+on:
+  push:
+  pull_request:
+
+jobs:
+  Aligo:
+    name: Aligo
+    runs-on: ubuntu-latest
+
+    steps:
+      - name: Checkout
+        uses: actions/checkout@v6
+
+      - name: Set up Go
+        uses: actions/setup-go@v6
+        with:
+          go-version: 'stable'
+
+      - name: Check Golang sources with Aligo
+        uses: essentialkaos/aligo-action@v2
+        with:
+          files: %s
+`
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// initProject creates configuration file, baseline and CI workflow in the
+// root directory of current module
+func initProject(dirs []string) (error, bool) {
+	if len(dirs) == 0 {
+		dirs = []string{"./..."}
+	}
+
+	root := getProjectRoot()
+	configFile := filepath.Join(root, CONFIG_FILE)
+	workflowFile := filepath.Join(root, WORKFLOW_FILE)
+	baselineFile := getBaselineFile()
+
+	if !options.Has(OPT_BASELINE) && config.Baseline == "" {
+		baselineFile = filepath.Join(root, DEFAULT_BASELINE)
+	}
+
+	err := checkFileExists(configFile)
+
+	if err == nil && options.GetB(OPT_WORKFLOW) {
+		err = checkFileExists(workflowFile)
+	}
+
+	if err == nil && options.GetB(OPT_WRITE_BASE) {
+		err = checkFileExists(baselineFile)
+	}
+
+	if err != nil {
+		return err, false
+	}
+
+	r, err := inspect.ProcessSources(dirs, getTags(), filter, inspect.DefaultOptions())
+
+	if err != nil {
+		return err, false
+	}
+
+	if r == nil {
+		r = &report.Report{}
+	}
+
+	var baseline string
+
+	if options.GetB(OPT_WRITE_BASE) {
+		count, err := writeBaseline(r, baselineFile)
+
+		if err != nil {
+			return err, false
+		}
+
+		fmtc.Printfn(i18n.UI.INFO.BASELINE_SAVED.String(), count, getDisplayPath(baselineFile))
+
+		baseline = getRootFile(root, baselineFile)
+	}
+
+	// Paths in configuration file and workflow are relative to the module root
+	dirs = getRootPaths(root, dirs)
+
+	err = os.WriteFile(configFile, []byte(genConfig(dirs, baseline)), 0644)
+
+	if err != nil {
+		return err, false
+	}
+
+	fmtc.Printfn(i18n.UI.INFO.CONFIG_SAVED.String(), getDisplayPath(configFile))
+
+	workflow := fmt.Sprintf(workflowTemplate, quoteYAML(strings.Join(dirs, " ")))
+
+	if options.GetB(OPT_WORKFLOW) {
+		err = os.MkdirAll(filepath.Dir(workflowFile), 0755)
+
+		if err == nil {
+			err = os.WriteFile(workflowFile, []byte(workflow), 0644)
+		}
+
+		if err != nil {
+			return err, false
+		}
+
+		fmtc.Printfn(i18n.UI.INFO.WORKFLOW_SAVED.String(), getDisplayPath(workflowFile))
+
+		return nil, true
+	}
+
+	fmtc.NewLine()
+	fmtc.Printfn(i18n.UI.INFO.WORKFLOW_SNIPPET.String(), getDisplayPath(workflowFile))
+	fmtutil.Separator(true)
+	fmtc.Print(workflow)
+	fmtutil.Separator(true)
+
+	return nil, true
+}
+
+// checkFileExists returns error if file already exists and update is not allowed
+func checkFileExists(file string) error {
+	_, err := os.Stat(file)
+
+	if err == nil && !options.GetB(OPT_UPDATE) {
+		return i18n.UI.ERRORS.FILE_EXISTS.Error(file)
+	}
+
+	return nil
+}
+
+// getProjectRoot returns path to the module root directory with go.mod file,
+// top-level directory of git repository or current directory
+func getProjectRoot() string {
+	cwd := getWorkingDir()
+
+	if root := getModuleRoot(cwd); root != "" {
+		return root
+	}
+
+	if output, err := runGit("", "rev-parse", "--show-toplevel"); err == nil {
+		return strings.TrimSpace(string(output))
+	}
+
+	return cwd
+}
+
+// getRootPaths converts paths relative to current directory to paths relative
+// to given root directory
+func getRootPaths(root string, paths []string) []string {
+	cwd := getWorkingDir()
+
+	var result []string
+
+	for _, path := range paths {
+		if !filepath.IsAbs(path) && (path == "." || path == ".." || strings.HasPrefix(path, "./") || strings.HasPrefix(path, "../")) {
+			relPath, err := filepath.Rel(root, filepath.Join(cwd, path))
+
+			if err == nil {
+				relPath = filepath.ToSlash(relPath)
+
+				switch {
+				case relPath == ".":
+					path = "."
+				case relPath == ".." || strings.HasPrefix(relPath, "../"):
+					path = relPath
+				default:
+					path = "./" + relPath
+				}
+			}
+		}
+
+		result = append(result, path)
+	}
+
+	return result
+}
+
+// getRootFile converts path to file relative to current directory to path
+// relative to given root directory. Absolute paths to files outside of the
+// root directory are kept as is.
+func getRootFile(root, file string) string {
+	absPath := file
+
+	if !filepath.IsAbs(file) {
+		absPath = filepath.Join(getWorkingDir(), file)
+	}
+
+	relPath, err := filepath.Rel(root, absPath)
+
+	if err != nil || (filepath.IsAbs(file) && strings.HasPrefix(relPath, "..")) {
+		return file
+	}
+
+	return filepath.ToSlash(relPath)
+}
+
+// getDisplayPath returns path to file relative to current directory
+func getDisplayPath(file string) string {
+	relPath, err := filepath.Rel(getWorkingDir(), file)
+
+	if err != nil {
+		return file
+	}
+
+	return relPath
+}
+
+// genConfig generates starter configuration
+func genConfig(dirs []string, baseline string) string {
+	var buf strings.Builder
+
+	buf.WriteString("# Paths to packages for checking\npaths:\n")
+
+	for _, dir := range dirs {
+		fmt.Fprintf(&buf, "  - %s\n", quoteYAML(dir))
+	}
+
+	fmt.Fprintf(&buf, "\n# Architectures for calculating sizes\narch: %s\n", formatYAMLList(inspect.Archs))
+
+	if len(getTags()) != 0 {
+		fmt.Fprintf(&buf, "\n# Build tags\ntags: %s\n", formatYAMLList(getTags()))
+	}
+
+	if len(getExcludes()) != 0 {
+		fmt.Fprintf(&buf, "\n# Excluded packages and files\nexclude: %s\n", formatYAMLList(getExcludes()))
+	}
+
+	if baseline != "" {
+		fmt.Fprintf(&buf, "\n# Baseline with known problems\nbaseline: %s\n", quoteYAML(baseline))
+	}
+
+	buf.WriteString("\n# Minimal number of wasted bytes for reporting struct\nmin-waste: 0\n")
+	buf.WriteString("\n# Structs which must be ignored (Name or package/path.Name)\n# ignore:\n#   - MyStruct\n")
+
+	return buf.String()
+}
+
+// formatYAMLList formats list of strings as YAML flow sequence
+func formatYAMLList(values []string) string {
+	var result []string
+
+	for _, value := range values {
+		result = append(result, quoteYAML(value))
+	}
+
+	return "[" + strings.Join(result, ", ") + "]"
+}
+
+// quoteYAML formats string as double-quoted YAML scalar. JSON strings are
+// valid YAML scalars, so patterns like "**/mocks/**" are kept as is.
+func quoteYAML(value string) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
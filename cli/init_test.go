@@ -0,0 +1,164 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/inspect"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestGenConfig(t *testing.T) {
+	defer func() { config, inspect.Archs = &Config{}, nil }()
+
+	tests := []struct {
+		name     string
+		dirs     []string
+		excludes []string
+		baseline string
+	}{
+		{"simple values", []string{"./..."}, nil, ""},
+		{"glob patterns", []string{"./cmd/...", "./internal/..."}, []string{"**/mocks/**", "*_gen.go"}, ".aligo-baseline.json"},
+		{"special characters", []string{"./#tmp/...", "./a: b/..."}, []string{"[ab]*", "&ref", "'quoted'"}, "base line: \"x\".json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config = &Config{Exclude: tt.excludes}
+			inspect.Archs = []string{"amd64", "arm64"}
+
+			data := genConfig(tt.dirs, tt.baseline)
+			config = &Config{}
+
+			if err := decodeYAMLConfig(CONFIG_FILE, []byte(data)); err != nil {
+				t.Fatalf("can't decode generated configuration: %v\n%s", err, data)
+			}
+
+			switch {
+			case !slices.Equal(config.Paths, tt.dirs):
+				t.Errorf("got paths %q, want %q", config.Paths, tt.dirs)
+			case !slices.Equal(config.Exclude, tt.excludes):
+				t.Errorf("got excludes %q, want %q", config.Exclude, tt.excludes)
+			case config.Baseline != tt.baseline:
+				t.Errorf("got baseline %q, want %q", config.Baseline, tt.baseline)
+			case !slices.Equal(config.Arch, inspect.Archs):
+				t.Errorf("got archs %q, want %q", config.Arch, inspect.Archs)
+			}
+		})
+	}
+}
+
+func TestInitProject(t *testing.T) {
+	defer func() { config, inspect.Archs = &Config{}, nil }()
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "api")
+
+	err := os.MkdirAll(dir, 0755)
+
+	if err == nil {
+		err = os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/test\n\ngo 1.23\n"), 0644)
+	}
+
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, "api.go"), []byte("package api\n\ntype Data struct {\n\tA int64\n}\n"), 0644)
+	}
+
+	if err != nil {
+		t.Fatalf("can't create module: %v", err)
+	}
+
+	t.Chdir(dir)
+
+	captureOutput(t, func() error {
+		err, _ := initProject([]string{"./..."})
+		return err
+	})
+
+	if _, err := os.Stat(filepath.Join(dir, CONFIG_FILE)); err == nil {
+		t.Errorf("configuration file is saved to current directory")
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, CONFIG_FILE))
+
+	if err != nil {
+		t.Fatalf("configuration file is not saved to module root: %v", err)
+	}
+
+	config = &Config{}
+
+	if err = decodeYAMLConfig(CONFIG_FILE, data); err != nil {
+		t.Fatalf("can't decode generated configuration: %v", err)
+	}
+
+	if !slices.Equal(config.Paths, []string{"./api/..."}) {
+		t.Errorf("got paths %q, want paths relative to module root", config.Paths)
+	}
+}
+
+func TestGetProjectRoot(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "api", "v1")
+
+	err := os.MkdirAll(dir, 0755)
+
+	if err == nil {
+		err = os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/test\n"), 0644)
+	}
+
+	if err != nil {
+		t.Fatalf("can't create module: %v", err)
+	}
+
+	t.Chdir(dir)
+
+	if got := getProjectRoot(); got != root {
+		t.Errorf("got project root %q, want %q", got, root)
+	}
+}
+
+func TestGetRootPaths(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "api")
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("can't create directory: %v", err)
+	}
+
+	t.Chdir(dir)
+
+	tests := []struct {
+		path string
+		want string
+		file string
+	}{
+		{"./...", "./api/...", "api/..."},
+		{".", "./api", "api"},
+		{"./v1/...", "./api/v1/...", "api/v1/..."},
+		{"..", ".", "."},
+		{"../web/...", "./web/...", "web/..."},
+		{"example.com/test/...", "example.com/test/...", "api/example.com/test/..."},
+		{"/src/other/...", "/src/other/...", "/src/other/..."},
+		{filepath.Join(root, "web"), filepath.Join(root, "web"), "web"},
+	}
+
+	for _, tt := range tests {
+		if got := getRootPaths(root, []string{tt.path}); !slices.Equal(got, []string{tt.want}) {
+			t.Errorf("getRootPaths(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+
+		if got := getRootFile(root, tt.path); got != tt.file {
+			t.Errorf("getRootFile(%q) = %q, want %q", tt.path, got, tt.file)
+		}
+	}
+}
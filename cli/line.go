@@ -0,0 +1,39 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printLines prints problems from report in compiler-style format
+// (file:line:col: message). Paths are relative to the module root, so
+// editors and CI can resolve them regardless of the current directory. Paths
+// of files outside of the module root are absolute.
+func printLines(r *report.Report, root string, absolute bool) error {
+	for _, p := range getProblems(r) {
+		path := getRelativePath(root, p.Struct.Position.Path)
+
+		if absolute || path == ".." || strings.HasPrefix(path, "../") {
+			path = filepath.ToSlash(p.Struct.Position.Path)
+		}
+
+		fmt.Printf(
+			"%s:%d:%d: %s\n", path,
+			p.Struct.Position.Line, p.Struct.Position.Column, p.Message,
+		)
+	}
+
+	return nil
+}
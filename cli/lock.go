@@ -0,0 +1,152 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DEFAULT_LOCK is default name of lock file with structs sizes
+const DEFAULT_LOCK = "aligo.lock"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Lock contains sizes of structs on every arch keyed by package path and
+// struct name
+type Lock struct {
+	Archs   []string                    `json:"archs"`
+	Structs map[string]map[string]int64 `json:"structs"`
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// writeLock writes sizes of all structs from report to lock file. Entries of
+// previous lock for packages which weren't analyzed are kept as is.
+func writeLock(r *report.Report, archs []string, file string, prev *Lock) (int, error) {
+	lock := &Lock{Archs: archs, Structs: map[string]map[string]int64{}}
+
+	if prev != nil {
+		packages := getReportPackages(r)
+
+		for key, sizes := range prev.Structs {
+			if !packages[getLockKeyPackage(key)] {
+				lock.Structs[key] = sizes
+			}
+		}
+	}
+
+	for _, pkg := range r.Packages {
+		for _, str := range pkg.Structs {
+			lock.Structs[getBaselineKey(pkg, str)] = str.ArchSizes
+		}
+	}
+
+	data, err := json.MarshalIndent(lock, "", "  ")
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(lock.Structs), os.WriteFile(file, append(data, '\n'), 0644)
+}
+
+// readLock reads lock file
+func readLock(file string) (*Lock, error) {
+	data, err := os.ReadFile(file)
+
+	if err != nil {
+		return nil, i18n.UI.ERRORS.CANT_READ_LOCK.Error(file, err)
+	}
+
+	lock := &Lock{}
+	err = json.Unmarshal(data, lock)
+
+	if err != nil {
+		return nil, i18n.UI.ERRORS.CANT_READ_LOCK.Error(file, err)
+	}
+
+	return lock, nil
+}
+
+// applyLock adds warnings about structs which sizes differ from sizes
+// in lock file and returns sorted keys of locked structs which don't exist
+// anymore in analyzed packages
+func applyLock(r *report.Report, lock *Lock) []string {
+	var removed []string
+
+	packages := getReportPackages(r)
+	existing := map[string]bool{}
+
+	for _, pkg := range r.Packages {
+		for _, str := range pkg.Structs {
+			key := getBaselineKey(pkg, str)
+			lockedSizes, ok := lock.Structs[key]
+			existing[key] = true
+
+			if !ok {
+				str.Warnings = append(str.Warnings, &report.Warning{Type: report.WARN_NOT_LOCKED})
+				continue
+			}
+
+			for _, arch := range lock.Archs {
+				size, hasSize := str.ArchSizes[arch]
+				lockedSize, hasLockedSize := lockedSizes[arch]
+
+				if !hasSize || !hasLockedSize || size == lockedSize {
+					continue
+				}
+
+				str.Warnings = append(str.Warnings, &report.Warning{
+					Type:       report.WARN_LOCKED_SIZE,
+					Arch:       arch,
+					Size:       size,
+					LockedSize: lockedSize,
+				})
+			}
+		}
+	}
+
+	for key := range lock.Structs {
+		if packages[getLockKeyPackage(key)] && !existing[key] {
+			removed = append(removed, key)
+		}
+	}
+
+	slices.Sort(removed)
+
+	return removed
+}
+
+// isSameArchs returns true if both lists contain the same architectures
+func isSameArchs(archs1, archs2 []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(archs1)), slices.Sorted(slices.Values(archs2)))
+}
+
+// getReportPackages returns set of paths of all packages in report
+func getReportPackages(r *report.Report) map[string]bool {
+	result := map[string]bool{}
+
+	for _, pkg := range r.Packages {
+		result[pkg.Path] = true
+	}
+
+	return result
+}
+
+// getLockKeyPackage returns package path from lock key
+func getLockKeyPackage(key string) string {
+	return key[:max(strings.LastIndex(key, "."), 0)]
+}
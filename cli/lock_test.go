@@ -0,0 +1,166 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestApplyLock(t *testing.T) {
+	lock := &Lock{
+		Archs: []string{"amd64", "386"},
+		Structs: map[string]map[string]int64{
+			"example.com/a.Same":    {"amd64": 16, "386": 12},
+			"example.com/a.Grew":    {"amd64": 16, "386": 12},
+			"example.com/a.Removed": {"amd64": 8, "386": 8},
+			"example.com/a.Ignored": {"amd64": 8, "386": 8},
+			"example.com/b.Skipped": {"amd64": 8, "386": 8},
+		},
+	}
+
+	r := &report.Report{Packages: []*report.Package{
+		{Path: "example.com/a", Structs: []*report.Struct{
+			{Name: "Same", ArchSizes: map[string]int64{"amd64": 16, "386": 12}},
+			{Name: "Grew", ArchSizes: map[string]int64{"amd64": 24, "386": 12}},
+			{Name: "New", ArchSizes: map[string]int64{"amd64": 8, "386": 8}},
+			{Name: "Ignored", Ignore: true, ArchSizes: map[string]int64{"amd64": 16, "386": 12}},
+			{Name: "IgnoredNew", Ignore: true, ArchSizes: map[string]int64{"amd64": 8, "386": 8}},
+		}},
+	}}
+
+	removed := applyLock(r, lock)
+
+	if !slices.Equal(removed, []string{"example.com/a.Removed"}) {
+		t.Errorf("got removed structs %v, want [example.com/a.Removed]", removed)
+	}
+
+	tests := []struct {
+		str      int
+		warnings []string
+	}{
+		{0, nil},
+		{1, []string{report.WARN_LOCKED_SIZE + ":amd64"}},
+		{2, []string{report.WARN_NOT_LOCKED + ":"}},
+		{3, []string{report.WARN_LOCKED_SIZE + ":amd64", report.WARN_LOCKED_SIZE + ":386"}},
+		{4, []string{report.WARN_NOT_LOCKED + ":"}},
+	}
+
+	for _, tt := range tests {
+		str := r.Packages[0].Structs[tt.str]
+
+		var got []string
+
+		for _, w := range str.ReportedWarnings() {
+			got = append(got, w.Type+":"+w.Arch)
+		}
+
+		if !slices.Equal(got, tt.warnings) {
+			t.Errorf("%s: got warnings %v, want %v", str.Name, got, tt.warnings)
+		}
+
+		if isProblemStruct(str) != (tt.warnings != nil) {
+			t.Errorf("%s: got problem %t, want %t", str.Name, isProblemStruct(str), tt.warnings != nil)
+		}
+	}
+}
+
+func TestWriteLock(t *testing.T) {
+	file := filepath.Join(t.TempDir(), DEFAULT_LOCK)
+
+	prev := &Lock{
+		Archs: []string{"amd64"},
+		Structs: map[string]map[string]int64{
+			"example.com/a.Removed": {"amd64": 8},
+			"example.com/a.Grew":    {"amd64": 8},
+			"example.com/a/b.Other": {"amd64": 8},
+		},
+	}
+
+	r := &report.Report{Packages: []*report.Package{
+		{Path: "example.com/a", Structs: []*report.Struct{
+			{Name: "Grew", ArchSizes: map[string]int64{"amd64": 16}},
+		}},
+	}}
+
+	count, err := writeLock(r, []string{"amd64"}, file, prev)
+
+	if err != nil {
+		t.Fatalf("can't write lock: %v", err)
+	}
+
+	lock, err := readLock(file)
+
+	if err != nil {
+		t.Fatalf("can't read lock: %v", err)
+	}
+
+	tests := []struct {
+		key    string
+		size   int64
+		exists bool
+	}{
+		{"example.com/a.Grew", 16, true},
+		{"example.com/a.Removed", 0, false},
+		{"example.com/a/b.Other", 8, true},
+	}
+
+	if count != 2 {
+		t.Errorf("got %d structs, want 2", count)
+	}
+
+	for _, tt := range tests {
+		sizes, ok := lock.Structs[tt.key]
+
+		if ok != tt.exists || sizes["amd64"] != tt.size {
+			t.Errorf("%s: got %v (exists: %t), want size %d (exists: %t)", tt.key, sizes, ok, tt.size, tt.exists)
+		}
+	}
+}
+
+func TestGetLockFile(t *testing.T) {
+	defer func() { config = &Config{} }()
+
+	tests := []struct {
+		config string
+		want   string
+	}{
+		{"", DEFAULT_LOCK},
+		{"/tmp/project/sizes.lock", "/tmp/project/sizes.lock"},
+	}
+
+	for _, tt := range tests {
+		config = &Config{Lock: tt.config}
+
+		if got := getLockFile(); got != tt.want {
+			t.Errorf("got lock file %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestIsSameArchs(t *testing.T) {
+	tests := []struct {
+		archs1, archs2 []string
+		want           bool
+	}{
+		{[]string{"amd64", "386"}, []string{"386", "amd64"}, true},
+		{[]string{"amd64"}, []string{"amd64", "arm64"}, false},
+		{[]string{"amd64"}, []string{"arm64"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := isSameArchs(tt.archs1, tt.archs2); got != tt.want {
+			t.Errorf("isSameArchs(%v, %v) = %t, want %t", tt.archs1, tt.archs2, got, tt.want)
+		}
+	}
+}
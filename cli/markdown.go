@@ -0,0 +1,176 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printMarkdown prints report in Markdown format with collapsible section
+// per package
+func printMarkdown(r *report.Report, root string) error {
+	var buf strings.Builder
+
+	problems := map[*report.Struct][]*problem{}
+
+	for _, p := range getProblems(r) {
+		problems[p.Struct] = append(problems[p.Struct], p)
+	}
+
+	buf.WriteString("## " + APP + "\n\n")
+
+	if r.IsEmpty() {
+		buf.WriteString("All structs are well aligned\n")
+	}
+
+	for _, pkg := range r.Packages {
+		if pkg.IsEmpty() {
+			continue
+		}
+
+		var wasted int64
+
+		for _, str := range pkg.Structs {
+			if !isAlignedStruct(str) {
+				wasted += str.Size - str.OptimalSize
+			}
+		}
+
+		fmt.Fprintf(
+			&buf, "<details>\n<summary><code>%s</code> (structs: %d, wasted: %d B)</summary>\n\n",
+			pkg.Path, len(pkg.Structs), wasted,
+		)
+
+		for _, str := range pkg.Structs {
+			writeMarkdownStruct(&buf, root, str, problems[str])
+		}
+
+		buf.WriteString("</details>\n\n")
+	}
+
+	_, err := os.Stdout.WriteString(buf.String())
+
+	return err
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// writeMarkdownStruct writes info about struct in Markdown format
+func writeMarkdownStruct(buf *strings.Builder, root string, str *report.Struct, problems []*problem) {
+	fmt.Fprintf(
+		buf, "#### `%s`\n\n`%s:%d`\n\n",
+		str.Name, getRelativePath(root, str.Position.Path), str.Position.Line,
+	)
+
+	if isAlignedStruct(str) {
+		fmt.Fprintf(buf, "Size: **%d B**\n\n", str.Size)
+	} else {
+		fmt.Fprintf(
+			buf, "| Current | Optimal | Saved |\n|--------:|--------:|------:|\n| %d B | %d B | %d B |\n\n",
+			str.Size, str.OptimalSize, str.Size-str.OptimalSize,
+		)
+	}
+
+	for _, p := range problems {
+		buf.WriteString("- " + p.Message + "\n")
+	}
+
+	if len(problems) != 0 {
+		buf.WriteString("\n")
+	}
+
+	if !isAlignedStruct(str) && str.Suggestion != "" {
+		suggestion := getMarkdownSuggestion(str)
+
+		if suggestion != "" {
+			fmt.Fprintf(buf, "```suggestion\n%s\n```\n\n", suggestion)
+		}
+	}
+}
+
+// getMarkdownSuggestion returns source lines of struct declaration with doc
+// comments where struct type is replaced by suggestion
+func getMarkdownSuggestion(str *report.Struct) string {
+	src, err := os.ReadFile(str.Position.Path)
+
+	if err != nil {
+		return ""
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, str.Position.Path, src, parser.ParseComments)
+
+	if err != nil {
+		return ""
+	}
+
+	decl, spec := findTypeSpec(fset, file, str)
+
+	if spec == nil {
+		return ""
+	}
+
+	start, end := spec.Pos(), spec.Type.End()
+
+	switch {
+	case spec.Doc != nil:
+		start = spec.Doc.Pos()
+	case decl.Lparen == token.NoPos && decl.Doc != nil:
+		start = decl.Doc.Pos()
+	case decl.Lparen == token.NoPos:
+		start = decl.Pos()
+	}
+
+	startOffset := fset.Position(start).Offset
+	typeOffset := fset.Position(spec.Type.Pos()).Offset
+	endOffset := fset.Position(end).Offset
+
+	lineStart := strings.LastIndexByte(string(src[:startOffset]), '\n') + 1
+	lineEnd := len(src)
+
+	if i := strings.IndexByte(string(src[endOffset:]), '\n'); i != -1 {
+		lineEnd = endOffset + i
+	}
+
+	typeLine := string(src[strings.LastIndexByte(string(src[:typeOffset]), '\n')+1 : typeOffset])
+	indent := typeLine[:len(typeLine)-len(strings.TrimLeft(typeLine, " \t"))]
+
+	return string(src[lineStart:typeOffset]) +
+		strings.ReplaceAll(str.Suggestion, "\n", "\n"+indent) +
+		string(src[endOffset:lineEnd])
+}
+
+// findTypeSpec finds declaration of struct type in parsed file
+func findTypeSpec(fset *token.FileSet, file *ast.File, str *report.Struct) (*ast.GenDecl, *ast.TypeSpec) {
+	for _, d := range file.Decls {
+		decl, ok := d.(*ast.GenDecl)
+
+		if !ok || decl.Tok != token.TYPE || fset.Position(decl.TokPos).Line != str.Position.Line {
+			continue
+		}
+
+		for _, s := range decl.Specs {
+			spec := s.(*ast.TypeSpec)
+
+			if _, isStruct := spec.Type.(*ast.StructType); isStruct && spec.Name.Name == str.Name {
+				return decl, spec
+			}
+		}
+	}
+
+	return nil, nil
+}
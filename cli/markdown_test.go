@@ -0,0 +1,97 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const testMarkdownSource = `package test
+
+// Single is single declaration
+type Single struct {
+	A bool
+	B int64
+}
+
+type (
+	// Grouped is declared in group
+	Grouped struct {
+		A bool
+		B int64
+	}
+
+	Other struct {
+		A bool
+		B int64
+	} // trailing comment
+)
+
+type Generic[T any] struct {
+	A bool
+	B T
+}
+`
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestMarkdownSuggestion(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "test.go")
+	err := os.WriteFile(file, []byte(testMarkdownSource), 0644)
+
+	if err != nil {
+		t.Fatalf("can't write source: %v", err)
+	}
+
+	suggestion := "struct {\n\tB int64\n\tA bool\n}"
+
+	tests := []struct {
+		name string
+		line int
+		want string
+	}{
+		{
+			"Single", 4,
+			"// Single is single declaration\ntype Single struct {\n\tB int64\n\tA bool\n}",
+		},
+		{
+			"Grouped", 9,
+			"\t// Grouped is declared in group\n\tGrouped struct {\n\t\tB int64\n\t\tA bool\n\t}",
+		},
+		{
+			"Other", 9,
+			"\tOther struct {\n\t\tB int64\n\t\tA bool\n\t} // trailing comment",
+		},
+		{
+			"Generic", 22,
+			"type Generic[T any] struct {\n\tB int64\n\tA bool\n}",
+		},
+		{"Unknown", 4, ""},
+		{"Single", 9, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			str := &report.Struct{
+				Name:       tt.name,
+				Position:   report.Position{Path: file, Line: tt.line},
+				Suggestion: suggestion,
+			}
+
+			if got := getMarkdownSuggestion(str); got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
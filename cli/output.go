@@ -0,0 +1,138 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/essentialkaos/ek/v14/options"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Output formats
+const (
+	FORMAT_TEXT       = "text"
+	FORMAT_JSON       = "json"
+	FORMAT_SARIF      = "sarif"
+	FORMAT_CHECKSTYLE = "checkstyle"
+	FORMAT_JUNIT      = "junit"
+	FORMAT_GITHUB     = "github"
+	FORMAT_MARKDOWN   = "markdown"
+	FORMAT_HTML       = "html"
+	FORMAT_SVG        = "svg"
+	FORMAT_CSV        = "csv"
+	FORMAT_TSV        = "tsv"
+	FORMAT_LINE       = "line"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// formats is a list of supported output formats
+var formats = []string{
+	FORMAT_TEXT, FORMAT_JSON, FORMAT_SARIF, FORMAT_CHECKSTYLE, FORMAT_JUNIT,
+	FORMAT_GITHUB, FORMAT_MARKDOWN, FORMAT_HTML, FORMAT_SVG,
+	FORMAT_CSV, FORMAT_TSV, FORMAT_LINE,
+}
+
+// annotateFormats is a list of output formats supported by annotate command
+var annotateFormats = []string{FORMAT_TEXT, FORMAT_JSON}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printReport prints report in given format
+func printReport(r *report.Report, format string) error {
+	root := getModuleRoot(getWorkingDir())
+
+	switch format {
+	case FORMAT_JSON:
+		return printJSON(r)
+	case FORMAT_SARIF:
+		return printSARIF(r, root)
+	case FORMAT_CHECKSTYLE:
+		return printCheckstyle(r, root)
+	case FORMAT_JUNIT:
+		return printJUnit(r, root)
+	case FORMAT_GITHUB:
+		return printGitHub(r, root)
+	case FORMAT_MARKDOWN:
+		return printMarkdown(r, root)
+	case FORMAT_HTML:
+		return printHTML(r, root)
+	case FORMAT_SVG:
+		return printSVG(r)
+	case FORMAT_CSV:
+		return printCSV(r, root, ',', options.GetB(OPT_FIELDS))
+	case FORMAT_TSV:
+		return printCSV(r, root, '\t', options.GetB(OPT_FIELDS))
+	case FORMAT_LINE:
+		return printLines(r, root, options.GetB(OPT_ABSOLUTE))
+	}
+
+	return i18n.UI.ERRORS.UNSUPPORTED_FORMAT.Error(format)
+}
+
+// printFiles prints list of modified files in given format
+func printFiles(files []string, generated int, format string) error {
+	switch format {
+	case FORMAT_JSON:
+		if files == nil {
+			files = []string{}
+		}
+
+		return printJSON(map[string]any{"files": files, "generated": generated})
+	}
+
+	return i18n.UI.ERRORS.UNSUPPORTED_FORMAT.Error(format)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printJSON prints given data as indented JSON
+func printJSON(data any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(data)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// copyPackage returns copy of package with given structs
+func copyPackage(pkg *report.Package, structs []*report.Struct) *report.Package {
+	result := *pkg
+	result.Structs = structs
+
+	return &result
+}
+
+// filterProblems returns copy of report which contains only structs
+// with problems
+func filterProblems(r *report.Report) *report.Report {
+	result := &report.Report{Meta: r.Meta, Packages: []*report.Package{}}
+
+	for _, pkg := range r.Packages {
+		var structs []*report.Struct
+
+		for _, str := range pkg.Structs {
+			if isProblemStruct(str) {
+				structs = append(structs, str)
+			}
+		}
+
+		if len(structs) != 0 || pkg.Generated != 0 {
+			result.Packages = append(result.Packages, copyPackage(pkg, structs))
+		}
+	}
+
+	return result
+}
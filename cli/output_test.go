@@ -0,0 +1,236 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// testSource is source with structs from test report
+const testSource = `package api
+
+type Request struct {
+	Ready bool ` + "`" + `json:"ready"` + "`" + ` // Ready & <done>
+	Count int64
+	Ch    chan<- bool
+}
+
+type Limited struct {
+	A, B int64
+}
+
+type Aligned struct {
+	A int64
+}
+`
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestFilterProblems(t *testing.T) {
+	padded := &report.Struct{Name: "Padded", Size: 24, OptimalSize: 16}
+	aligned := &report.Struct{Name: "Aligned", Size: 16, OptimalSize: 16}
+
+	r := &report.Report{
+		Meta: &report.Meta{Arch: "amd64"},
+		Packages: []*report.Package{
+			{Path: "example.com/a", Structs: []*report.Struct{padded, aligned}, Generated: 3},
+			{Path: "example.com/b", Structs: []*report.Struct{aligned}, Generated: 2},
+			{Path: "example.com/c", Structs: []*report.Struct{aligned}},
+			{Path: "example.com/d", Structs: []*report.Struct{padded}},
+		},
+	}
+
+	baseline := &Baseline{Structs: map[string]*BaselineStruct{
+		"example.com/d.Padded": {Wasted: 8},
+	}}
+
+	tests := []struct {
+		name   string
+		result *report.Report
+		want   []string
+	}{
+		{
+			"problems",
+			filterProblems(r),
+			[]string{"example.com/a:[Padded]:3", "example.com/b:[]:2", "example.com/d:[Padded]:0"},
+		},
+		{
+			"baseline",
+			applyBaseline(r, baseline),
+			[]string{"example.com/a:[Padded Aligned]:3", "example.com/b:[Aligned]:2", "example.com/c:[Aligned]:0"},
+		},
+		{
+			"changes",
+			filterChanged(r, changes{}),
+			[]string{"example.com/a:[Aligned]:3", "example.com/b:[Aligned]:2", "example.com/c:[Aligned]:0"},
+		},
+	}
+
+	for _, tt := range tests {
+		var got []string
+
+		if tt.result.Meta != r.Meta {
+			t.Errorf("%s: metadata is not copied", tt.name)
+		}
+
+		for _, pkg := range tt.result.Packages {
+			var names []string
+
+			for _, str := range pkg.Structs {
+				names = append(names, str.Name)
+			}
+
+			got = append(got, fmt.Sprintf("%s:%v:%d", pkg.Path, names, pkg.Generated))
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got packages %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPrintLines(t *testing.T) {
+	root := t.TempDir()
+	r := getTestReport(root)
+
+	tests := []struct {
+		name     string
+		root     string
+		absolute bool
+		want     string
+	}{
+		{"module root", root, false, "api/types.go:3:1: Struct Request fields order can be optimized (24 → 16)"},
+		{"nested module root", filepath.Join(root, "api"), false, "types.go:3:1: Struct Request fields order can be optimized (24 → 16)"},
+		{"file outside of module", filepath.Join(root, "web"), false, root + "/api/types.go:3:1: Struct Request"},
+		{"absolute paths", root, true, root + "/api/types.go:3:1: Struct Request"},
+		{"unknown module root", "", false, root + "/api/types.go:3:1: Struct Request"},
+	}
+
+	for _, tt := range tests {
+		out := captureOutput(t, func() error { return printLines(r, tt.root, tt.absolute) })
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+
+		if len(lines) != 2 {
+			t.Fatalf("%s: got %d lines, want 2:\n%s", tt.name, len(lines), out)
+		}
+
+		if !strings.HasPrefix(lines[0], tt.want) {
+			t.Errorf("%s: got line %q, want line starting with %q", tt.name, lines[0], tt.want)
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getTestReport returns small report with structs from module in given directory
+func getTestReport(root string) *report.Report {
+	file := filepath.Join(root, "api", "types.go")
+
+	return &report.Report{
+		Meta: &report.Meta{Tool: APP, Version: VER, Arch: "amd64", Archs: []string{"amd64"}, WordSize: 8, MaxAlign: 8},
+		Packages: []*report.Package{{
+			Path: "example.com/test/api",
+			Structs: []*report.Struct{
+				{
+					Name:     "Request",
+					Position: report.Position{File: "types.go", Path: file, Line: 3, Column: 1},
+					Fields: []*report.Field{
+						{Name: "Ready", Type: "bool", Tag: `json:"ready"`, Comment: "Ready & <done>", Size: 1, Padding: 7},
+						{Name: "Count", Type: "int64", Size: 8, Offset: 8},
+						{Name: "Ch", Type: "chan<- bool", Size: 8, Offset: 16},
+					},
+					AlignedFields: []*report.Field{
+						{Name: "Count", Type: "int64", Size: 8},
+						{Name: "Ch", Type: "chan<- bool", Size: 8, Offset: 8},
+						{Name: "Ready", Type: "bool", Tag: `json:"ready"`, Comment: "Ready & <done>", Size: 1, Offset: 16, Padding: 7},
+					},
+					Size:        24,
+					OptimalSize: 16,
+					Suggestion:  "struct {\n\tCount int64\n\tCh    chan<- bool\n\tReady bool `json:\"ready\"` // Ready & <done>\n}",
+				},
+				{
+					Name:     "Limited",
+					Position: report.Position{File: "types.go", Path: file, Line: 9, Column: 1},
+					Fields: []*report.Field{
+						{Name: "A", Type: "int64", Size: 8},
+						{Name: "B", Type: "int64", Size: 8, Offset: 8},
+					},
+					Warnings: []*report.Warning{
+						{Type: report.WARN_MAX_SIZE, Arch: "amd64", Size: 16, MaxSize: 8, Fields: []string{"B"}},
+						{Type: report.WARN_SERIALIZATION, Format: "json"},
+					},
+					Size:        16,
+					OptimalSize: 16,
+				},
+				{
+					Name:     "Aligned",
+					Position: report.Position{File: "types.go", Path: file, Line: 13, Column: 1},
+					Fields: []*report.Field{
+						{Name: "A", Type: "int64", Size: 8},
+					},
+					Size:        8,
+					OptimalSize: 8,
+				},
+			},
+		}},
+	}
+}
+
+// writeTestSource writes source with structs from test report to given directory
+func writeTestSource(t *testing.T, root string) {
+	dir := filepath.Join(root, "api")
+	err := os.MkdirAll(dir, 0755)
+
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, "types.go"), []byte(testSource), 0644)
+	}
+
+	if err != nil {
+		t.Fatalf("can't create source: %v", err)
+	}
+}
+
+// captureOutput returns data written to stdout by given function
+func captureOutput(t *testing.T, fn func() error) string {
+	r, w, err := os.Pipe()
+
+	if err != nil {
+		t.Fatalf("can't create pipe: %v", err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+
+	output := make(chan string)
+
+	go func() {
+		data, _ := io.ReadAll(r)
+		output <- string(data)
+	}()
+
+	err = fn()
+
+	os.Stdout = stdout
+	w.Close()
+
+	if err != nil {
+		t.Fatalf("can't print output: %v", err)
+	}
+
+	return <-output
+}
@@ -0,0 +1,101 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/essentialkaos/aligo/v2/messages"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// RULE_ALIGNMENT is ID of rule for structs with suboptimal fields order
+const RULE_ALIGNMENT = "alignment"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// problem contains info about single problem found in struct
+type problem struct {
+	Package *report.Package
+	Struct  *report.Struct
+	Rule    string
+	Message string
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getProblems returns list of problems found in report
+func getProblems(r *report.Report) []*problem {
+	var result []*problem
+
+	for _, pkg := range r.Packages {
+		for _, str := range pkg.Structs {
+			if !isProblemStruct(str) {
+				continue
+			}
+
+			if !isAlignedStruct(str) {
+				result = append(result, &problem{
+					pkg, str, RULE_ALIGNMENT, messages.Alignment(str),
+				})
+			}
+
+			for _, w := range str.ReportedWarnings() {
+				result = append(result, &problem{
+					pkg, str, w.Type, messages.StructWarning(str, w),
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+// getRelativePath returns path to file relative to given root directory
+func getRelativePath(root, path string) string {
+	if root == "" {
+		return filepath.ToSlash(path)
+	}
+
+	relPath, err := filepath.Rel(root, path)
+
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	return filepath.ToSlash(relPath)
+}
+
+// getModuleRoot returns path to the directory with go.mod file which contains
+// given directory
+func getModuleRoot(dir string) string {
+	for dir != "" {
+		_, err := os.Stat(filepath.Join(dir, "go.mod"))
+
+		if err == nil {
+			return dir
+		}
+
+		if filepath.Dir(dir) == dir {
+			break
+		}
+
+		dir = filepath.Dir(dir)
+	}
+
+	return ""
+}
+
+// getWorkingDir returns path to current working directory
+func getWorkingDir() string {
+	dir, _ := os.Getwd()
+	return dir
+}
@@ -0,0 +1,107 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestGetProblems(t *testing.T) {
+	r := getTestReport(t.TempDir())
+	structs := r.Packages[0].Structs
+
+	r.Packages[0].Structs = append(structs,
+		&report.Struct{Name: "Ignored", Size: 24, OptimalSize: 16, Ignore: true},
+		&report.Struct{Name: "Cgo", Size: 24, OptimalSize: 16, ABI: true},
+		&report.Struct{Name: "Small", Size: 24, OptimalSize: 16, MinWaste: 16},
+		&report.Struct{
+			Name: "Locked", Size: 16, OptimalSize: 16, Ignore: true,
+			Warnings: []*report.Warning{{Type: report.WARN_LOCKED_SIZE, Arch: "amd64", LockedSize: 8, Size: 16}},
+		},
+	)
+
+	tests := []struct {
+		str     string
+		rule    string
+		message string
+	}{
+		{"Request", RULE_ALIGNMENT, "Struct Request fields order can be optimized (24 → 16)"},
+		{"Limited", report.WARN_MAX_SIZE, "Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B"},
+		{"Locked", report.WARN_LOCKED_SIZE, "Struct Locked: Struct size changed from 8 to 16 on amd64"},
+	}
+
+	problems := getProblems(r)
+
+	if len(problems) != len(tests) {
+		t.Fatalf("got %d problems, want %d", len(problems), len(tests))
+	}
+
+	for i, tt := range tests {
+		p := problems[i]
+
+		if p.Package != r.Packages[0] {
+			t.Errorf("%s: got package %s", tt.str, p.Package.Path)
+		}
+
+		if p.Struct.Name != tt.str || p.Rule != tt.rule || p.Message != tt.message {
+			t.Errorf(
+				"got problem %s/%s %q, want %s/%s %q",
+				p.Struct.Name, p.Rule, p.Message, tt.str, tt.rule, tt.message,
+			)
+		}
+	}
+}
+
+func TestGetRelativePath(t *testing.T) {
+	tests := []struct {
+		root string
+		path string
+		want string
+	}{
+		{"/src/module", "/src/module/api/types.go", "api/types.go"},
+		{"/src/module/api", "/src/module/api/types.go", "types.go"},
+		{"/src/module/web", "/src/module/api/types.go", "../api/types.go"},
+		{"", "/src/module/api/types.go", "/src/module/api/types.go"},
+		{"/src/module", "api/types.go", "api/types.go"},
+	}
+
+	for _, tt := range tests {
+		if got := getRelativePath(tt.root, tt.path); got != tt.want {
+			t.Errorf("getRelativePath(%q, %q) = %q, want %q", tt.root, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestGetModuleRoot(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "api", "v1")
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("can't create directory: %v", err)
+	}
+
+	if getModuleRoot(dir) != "" {
+		t.Fatalf("got module root for directory without go.mod")
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/test\n"), 0644); err != nil {
+		t.Fatalf("can't create go.mod: %v", err)
+	}
+
+	for _, d := range []string{root, filepath.Join(root, "api"), dir} {
+		if got := getModuleRoot(d); got != root {
+			t.Errorf("getModuleRoot(%q) = %q, want %q", d, got, root)
+		}
+	}
+}
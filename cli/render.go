@@ -9,6 +9,7 @@ package cli
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/essentialkaos/ek/v14/fmtc"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/essentialkaos/aligo/v2/i18n"
 	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/messages"
 	"github.com/essentialkaos/aligo/v2/report"
 )
 
@@ -36,6 +38,11 @@ type Renderer struct {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// includeABI enables optimization advice for structs with fixed layout
+var includeABI bool
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // PrintFull prints all report info
 func PrintFull(r *report.Report) {
 	if isEmptyReport(r) {
@@ -49,30 +56,38 @@ func PrintFull(r *report.Report) {
 	}
 }
 
-// PrintStruct prints info about struct
-func PrintStruct(r *report.Report, strName string, optimal bool) {
+// PrintStructs prints info about structs matching given selectors and
+// returns false if some of them have problems
+func PrintStructs(r *report.Report, selectors []*structSelector, optimal bool) bool {
 	if isEmptyReport(r) {
-		return
+		return true
 	}
 
-	if strName == "" {
+	if len(selectors) == 0 {
 		terminal.Warn(i18n.UI.ERRORS.EMPTY_STRUCT_NAME)
-		return
+		return true
 	}
 
-	pkg, str := findStruct(r, strName)
+	selected := selectStructs(r, selectors)
 
-	if pkg == nil && str == nil {
-		terminal.Warn(i18n.UI.ERRORS.NO_STRUCT, strName)
-		return
+	if selected.IsEmpty() {
+		terminal.Warn(i18n.UI.ERRORS.NO_STRUCT, formatSelectors(selectors))
+		return true
 	}
 
-	printPackageSeparator(pkg.Path)
-	printStructInfo(str, optimal)
+	for _, pkg := range selected.Packages {
+		printPackageSeparator(pkg.Path)
+
+		for _, str := range pkg.Structs {
+			printStructInfo(str, optimal)
+		}
+	}
+
+	return filterProblems(selected).IsEmpty()
 }
 
 // Check checks report for problems
-func Check(r *report.Report) bool {
+func Check(r *report.Report, generated int) bool {
 	if isEmptyReport(r) {
 		return true
 	}
@@ -89,12 +104,17 @@ func Check(r *report.Report) bool {
 		printPackageInfo(pkg, true)
 	}
 
-	if !hasProblems {
+	switch {
+	case hasProblems:
+		printGeneratedSkipped(generated)
+		return false
+	case generated != 0:
+		fmtc.Printfn(i18n.UI.INFO.ALL_OPTIMAL_SKIPPED.String(), generated)
+	default:
 		fmtc.Println(i18n.UI.INFO.ALL_OPTIMAL)
-		return true
 	}
 
-	return false
+	return true
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -215,7 +235,7 @@ func printPackageInfo(pkg *report.Package, onlyProblems bool) {
 	printPackageSeparator(pkg.Path)
 
 	for _, str := range pkg.Structs {
-		if onlyProblems && isAlignedStruct(str) {
+		if onlyProblems && !isProblemStruct(str) {
 			continue
 		}
 
@@ -226,12 +246,24 @@ func printPackageInfo(pkg *report.Package, onlyProblems bool) {
 // printStructSizeInfo prints info about struct size
 func printStructSizeInfo(str *report.Struct, optimal bool) {
 	if optimal {
-		fmtc.Printf(
-			i18n.UI.INFO.OPTIMIZE_ADVICE.End("\n\n"),
-			str.Name, str.Position.File, str.Position.Line, str.Size, str.OptimalSize,
-		)
+		if isAlignedStruct(str) && hasLayoutWarnings(str) {
+			fmtc.Printf(
+				i18n.UI.INFO.HAS_WARNINGS.End("\n\n"),
+				str.Name, str.Position.File, str.Position.Line,
+			)
+		} else {
+			fmtc.Printf(
+				i18n.UI.INFO.OPTIMIZE_ADVICE.End("\n\n"),
+				str.Name, str.Position.File, str.Position.Line, str.Size, str.OptimalSize,
+			)
+		}
 	} else {
-		if str.Size != str.OptimalSize {
+		if str.ABI && !includeABI {
+			fmtc.Printf(
+				i18n.UI.INFO.FIXED_LAYOUT.Add("  ", "\n"),
+				str.Position.File, str.Position.Line, str.Size,
+			)
+		} else if str.Size != str.OptimalSize {
 			fmtc.Printf(
 				i18n.UI.INFO.WITH_OPTIMAL.Add("  ", "\n"),
 				str.Position.File, str.Position.Line, str.Size, str.OptimalSize,
@@ -245,9 +277,40 @@ func printStructSizeInfo(str *report.Struct, optimal bool) {
 	}
 }
 
+// printStructWarnings prints struct layout warnings
+func printStructWarnings(str *report.Struct) {
+	var hasWarnings bool
+
+	reported := str.ReportedWarnings()
+
+	for _, w := range str.Warnings {
+		switch {
+		case w.Type == report.WARN_SERIALIZATION && isAlignedStruct(str):
+			continue // order is not going to be changed
+		case w.Type != report.WARN_SERIALIZATION && !slices.Contains(reported, w):
+			continue // warning is suppressed by aligo:ignore
+		}
+
+		text := messages.WarningText(w)
+
+		if text == "" {
+			continue
+		}
+
+		fmtc.Println("  {y}▲{!} " + text)
+
+		hasWarnings = true
+	}
+
+	if hasWarnings {
+		fmtc.NewLine()
+	}
+}
+
 // printStructInfo prints struct info
 func printStructInfo(str *report.Struct, optimal bool) {
 	printStructSizeInfo(str, optimal)
+	printStructWarnings(str)
 
 	if str.Size == 0 {
 		fmtc.Printfn("  type {&}{*}%s{!} struct {s}{ }{!}\n", str.Name)
@@ -256,7 +319,7 @@ func printStructInfo(str *report.Struct, optimal bool) {
 
 	fmtc.Printfn("  type {&}{*}%s{!} struct {s}{{!}", str.Name)
 
-	if optimal {
+	if optimal && !isAlignedStruct(str) {
 		printAlignedFieldsInfo(str.AlignedFields)
 	} else {
 		printCurrentFieldsInfo(str.Fields)
@@ -330,24 +393,11 @@ func printCurrentFieldsInfo(fields []*report.Field) {
 	}
 }
 
-// findStruct finds struct with given name
-func findStruct(r *report.Report, name string) (*report.Package, *report.Struct) {
-	for _, pkg := range r.Packages {
-		for _, str := range pkg.Structs {
-			if str.Name == name {
-				return pkg, str
-			}
-		}
-	}
-
-	return nil, nil
-}
-
 // isPackageHasProblems returns true if package has structs with
 // unaligned fields
 func isPackageHasProblems(pkg *report.Package) bool {
 	for _, str := range pkg.Structs {
-		if !isAlignedStruct(str) {
+		if isProblemStruct(str) {
 			return true
 		}
 	}
@@ -357,5 +407,18 @@ func isPackageHasProblems(pkg *report.Package) bool {
 
 // isAlignedStruct returns false if struct has unaligned fields
 func isAlignedStruct(str *report.Struct) bool {
-	return str.Size == str.OptimalSize || str.Ignore
+	return str.Size == str.OptimalSize || str.Ignore || (str.ABI && !includeABI) ||
+		str.Size-str.OptimalSize < str.MinWaste
+}
+
+// isProblemStruct returns true if struct has unaligned fields or
+// layout warnings
+func isProblemStruct(str *report.Struct) bool {
+	return !isAlignedStruct(str) || hasLayoutWarnings(str)
+}
+
+// hasLayoutWarnings returns true if struct has warnings about differences
+// between Go and C layouts, exceeded size budget or changed locked size
+func hasLayoutWarnings(str *report.Struct) bool {
+	return len(str.ReportedWarnings()) != 0
 }
@@ -0,0 +1,77 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+
+	"github.com/essentialkaos/ek/v14/fmtc"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestPrintStructWarnings(t *testing.T) {
+	defer func(disabled bool) { fmtc.DisableColors = disabled }(fmtc.DisableColors)
+
+	fmtc.DisableColors = true
+
+	offset := &report.Warning{Type: report.WARN_ABI_OFFSET, Field: "B", Arch: "arm"}
+	budget := &report.Warning{Type: report.WARN_MAX_SIZE, Size: 24, MaxSize: 16, Arch: "amd64", Fields: []string{"B", "C"}}
+	serialization := &report.Warning{Type: report.WARN_SERIALIZATION, Format: "json"}
+
+	tests := []struct {
+		name string
+		str  *report.Struct
+		want string
+	}{
+		{
+			"layout warnings",
+			&report.Struct{Size: 16, OptimalSize: 16, Warnings: []*report.Warning{offset, budget}},
+			"  ▲ Field B has different offsets in Go and C layouts on arm\n" +
+				"  ▲ Struct size 24 exceeds budget 16 on amd64, fields over budget: B, C\n\n",
+		},
+		{
+			"serialization warning for padded struct",
+			&report.Struct{Size: 24, OptimalSize: 16, Warnings: []*report.Warning{serialization}},
+			"  ▲ Reordering changes order of fields in json output\n\n",
+		},
+		{
+			"serialization warning for aligned struct",
+			&report.Struct{Size: 16, OptimalSize: 16, Warnings: []*report.Warning{serialization}},
+			"",
+		},
+		{
+			"ignored struct",
+			&report.Struct{Size: 24, OptimalSize: 16, Ignore: true, Warnings: []*report.Warning{offset, budget, serialization}},
+			"  ▲ Struct size 24 exceeds budget 16 on amd64, fields over budget: B, C\n\n",
+		},
+		{
+			"unknown warning",
+			&report.Struct{Size: 16, OptimalSize: 16, Warnings: []*report.Warning{{Type: "unknown"}}},
+			"",
+		},
+		{
+			"no warnings",
+			&report.Struct{Size: 16, OptimalSize: 16},
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		out := captureOutput(t, func() error {
+			printStructWarnings(tt.str)
+			return nil
+		})
+
+		if out != tt.want {
+			t.Errorf("%s: got output:\n%q\nwant:\n%q", tt.name, out, tt.want)
+		}
+	}
+}
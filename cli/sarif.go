@@ -0,0 +1,224 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SARIF_SCHEMA is URL of SARIF 2.1.0 JSON schema
+const SARIF_SCHEMA = "https://json.schemastore.org/sarif-2.1.0.json"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type sarifLog struct {
+	Schema  string      `json:"$schema"`
+	Version string      `json:"version"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool      `json:"tool"`
+	Properties map[string]any `json:"properties,omitempty"`
+	Results    []*sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version"`
+	InformationURI string       `json:"informationUri"`
+	Rules          []*sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID     string           `json:"ruleId"`
+	Level      string           `json:"level"`
+	Message    sarifMessage     `json:"message"`
+	Properties map[string]any   `json:"properties"`
+	Locations  []*sarifLocation `json:"locations"`
+	Fixes      []*sarifFix      `json:"fixes,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage           `json:"description"`
+	ArtifactChanges []*sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []*sarifReplacement   `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion  `json:"deletedRegion"`
+	InsertedContent sarifMessage `json:"insertedContent"`
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// sarifRules is list of rules used in SARIF results
+var sarifRules = []*sarifRule{
+	{RULE_ALIGNMENT, sarifMessage{"Struct fields order can be optimized"}},
+	{report.WARN_ABI_OFFSET, sarifMessage{"Field has different offsets in Go and C layouts"}},
+	{report.WARN_ABI_SIZE, sarifMessage{"Struct has different sizes in Go and C layouts"}},
+	{report.WARN_ABI_ZERO_TAIL, sarifMessage{"Trailing zero-size field adds Go-specific padding"}},
+	{report.WARN_MAX_SIZE, sarifMessage{"Struct size exceeds budget"}},
+	{report.WARN_BUDGET_ARCH, sarifMessage{"Unknown architecture in size budget directive"}},
+	{report.WARN_LOCKED_SIZE, sarifMessage{"Struct size differs from size in lock file"}},
+	{report.WARN_NOT_LOCKED, sarifMessage{"Struct is not in lock file"}},
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printSARIF prints problems from report in SARIF 2.1.0 format
+func printSARIF(r *report.Report, root string) error {
+	run := &sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           APP,
+				Version:        VER,
+				InformationURI: "https://kaos.sh/aligo",
+				Rules:          sarifRules,
+			},
+		},
+		Results: []*sarifResult{},
+	}
+
+	if r.Meta != nil {
+		run.Properties = map[string]any{
+			"arch":     r.Meta.Arch,
+			"compiler": r.Meta.Compiler,
+		}
+	}
+
+	typeRegions := map[string]map[report.Position]sarifRegion{}
+
+	for _, p := range getProblems(r) {
+		file := p.Struct.Position.Path
+		uri := getRelativePath(root, file)
+
+		if typeRegions[file] == nil {
+			typeRegions[file] = getTypeRegions(file)
+		}
+
+		var bytesSaved int64
+
+		if !isAlignedStruct(p.Struct) {
+			bytesSaved = p.Struct.Size - p.Struct.OptimalSize
+		}
+
+		result := &sarifResult{
+			RuleID:  p.Rule,
+			Level:   "warning",
+			Message: sarifMessage{p.Message},
+			Properties: map[string]any{
+				"package":     p.Package.Path,
+				"struct":      p.Struct.Name,
+				"size":        p.Struct.Size,
+				"optimalSize": p.Struct.OptimalSize,
+				"bytesSaved":  bytesSaved,
+			},
+			Locations: []*sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{uri},
+					Region: sarifRegion{
+						StartLine:   p.Struct.Position.Line,
+						StartColumn: p.Struct.Position.Column,
+					},
+				},
+			}},
+		}
+
+		region, ok := typeRegions[file][p.Struct.Position]
+
+		if p.Rule == RULE_ALIGNMENT && p.Struct.Suggestion != "" && ok {
+			result.Fixes = []*sarifFix{{
+				Description: sarifMessage{"Reorder fields of " + p.Struct.Name},
+				ArtifactChanges: []*sarifArtifactChange{{
+					ArtifactLocation: sarifArtifactLocation{uri},
+					Replacements: []*sarifReplacement{{
+						DeletedRegion:   region,
+						InsertedContent: sarifMessage{p.Struct.Suggestion},
+					}},
+				}},
+			}}
+		}
+
+		run.Results = append(run.Results, result)
+	}
+
+	return printJSON(&sarifLog{
+		Schema:  SARIF_SCHEMA,
+		Version: "2.1.0",
+		Runs:    []*sarifRun{run},
+	})
+}
+
+// getTypeRegions returns regions of struct types in given file
+func getTypeRegions(file string) map[report.Position]sarifRegion {
+	result := map[report.Position]sarifRegion{}
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+
+	if err != nil {
+		return result
+	}
+
+	for pos, node := range inspect.FindStructNodes(fset, []*ast.File{astFile}) {
+		start, end := fset.Position(node.Type.Pos()), fset.Position(node.Type.End())
+
+		result[pos] = sarifRegion{
+			StartLine:   start.Line,
+			StartColumn: start.Column,
+			EndLine:     end.Line,
+			EndColumn:   end.Column,
+		}
+	}
+
+	return result
+}
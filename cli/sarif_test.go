@@ -0,0 +1,98 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestPrintSARIF(t *testing.T) {
+	root := t.TempDir()
+	writeTestSource(t, root)
+
+	out := captureOutput(t, func() error { return printSARIF(getTestReport(root), root) })
+
+	var log map[string]any
+
+	if err := json.Unmarshal([]byte(out), &log); err != nil {
+		t.Fatalf("can't decode SARIF: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		path []any
+		want any
+	}{
+		{"schema", []any{"$schema"}, SARIF_SCHEMA},
+		{"version", []any{"version"}, "2.1.0"},
+		{"tool name", []any{"runs", 0, "tool", "driver", "name"}, APP},
+		{"tool version", []any{"runs", 0, "tool", "driver", "version"}, VER},
+		{"first rule", []any{"runs", 0, "tool", "driver", "rules", 0, "id"}, RULE_ALIGNMENT},
+		{"arch", []any{"runs", 0, "properties", "arch"}, "amd64"},
+		{"results", []any{"runs", 0, "results", "#"}, 2.0},
+
+		{"alignment rule", []any{"runs", 0, "results", 0, "ruleId"}, RULE_ALIGNMENT},
+		{"alignment level", []any{"runs", 0, "results", 0, "level"}, "warning"},
+		{"alignment message", []any{"runs", 0, "results", 0, "message", "text"}, "Struct Request fields order can be optimized (24 → 16)"},
+		{"alignment uri", []any{"runs", 0, "results", 0, "locations", 0, "physicalLocation", "artifactLocation", "uri"}, "api/types.go"},
+		{"alignment line", []any{"runs", 0, "results", 0, "locations", 0, "physicalLocation", "region", "startLine"}, 3.0},
+		{"alignment column", []any{"runs", 0, "results", 0, "locations", 0, "physicalLocation", "region", "startColumn"}, 1.0},
+		{"alignment package", []any{"runs", 0, "results", 0, "properties", "package"}, "example.com/test/api"},
+		{"alignment bytes saved", []any{"runs", 0, "results", 0, "properties", "bytesSaved"}, 8.0},
+		{"fix uri", []any{"runs", 0, "results", 0, "fixes", 0, "artifactChanges", 0, "artifactLocation", "uri"}, "api/types.go"},
+		{"fix start line", []any{"runs", 0, "results", 0, "fixes", 0, "artifactChanges", 0, "replacements", 0, "deletedRegion", "startLine"}, 3.0},
+		{"fix start column", []any{"runs", 0, "results", 0, "fixes", 0, "artifactChanges", 0, "replacements", 0, "deletedRegion", "startColumn"}, 14.0},
+		{"fix end line", []any{"runs", 0, "results", 0, "fixes", 0, "artifactChanges", 0, "replacements", 0, "deletedRegion", "endLine"}, 7.0},
+		{"fix end column", []any{"runs", 0, "results", 0, "fixes", 0, "artifactChanges", 0, "replacements", 0, "deletedRegion", "endColumn"}, 2.0},
+
+		{"budget rule", []any{"runs", 0, "results", 1, "ruleId"}, "max-size"},
+		{"budget message", []any{"runs", 0, "results", 1, "message", "text"}, "Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B"},
+		{"budget line", []any{"runs", 0, "results", 1, "locations", 0, "physicalLocation", "region", "startLine"}, 9.0},
+		{"budget bytes saved", []any{"runs", 0, "results", 1, "properties", "bytesSaved"}, 0.0},
+		{"budget fixes", []any{"runs", 0, "results", 1, "fixes"}, nil},
+	}
+
+	for _, tt := range tests {
+		if got := getJSONValue(log, tt.path); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getJSONValue returns value from decoded JSON by path of keys and indexes,
+// "#" returns length of array
+func getJSONValue(data any, path []any) any {
+	for _, key := range path {
+		switch v := data.(type) {
+		case map[string]any:
+			k, _ := key.(string)
+			data = v[k]
+		case []any:
+			if key == "#" {
+				return float64(len(v))
+			}
+
+			i, _ := key.(int)
+
+			if i >= len(v) {
+				return nil
+			}
+
+			data = v[i]
+		default:
+			return nil
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,127 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// structSelector contains pattern for selecting structs
+type structSelector struct {
+	Regexp  *regexp.Regexp // regular expression for struct name or qualified name
+	Pattern string         // original pattern
+	Package string         // glob pattern for package import path
+	Name    string         // glob pattern for struct name
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseSelectors parses list of struct selectors. Selector can be struct name,
+// qualified name (pkg/path.Name), glob pattern or regular expression
+// wrapped in slashes (/^Config$/).
+func parseSelectors(value string) ([]*structSelector, error) {
+	var result []*structSelector
+
+	for _, pattern := range strings.Fields(value) {
+		selector, err := parseSelector(pattern)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, selector)
+	}
+
+	return result, nil
+}
+
+// parseSelector parses struct selector
+func parseSelector(pattern string) (*structSelector, error) {
+	if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+
+		if err != nil {
+			return nil, i18n.UI.ERRORS.INVALID_REGEXP.Error(pattern, err)
+		}
+
+		return &structSelector{Regexp: re, Pattern: pattern}, nil
+	}
+
+	index := strings.LastIndex(pattern, ".")
+
+	if index == -1 {
+		return &structSelector{Name: pattern, Pattern: pattern}, nil
+	}
+
+	return &structSelector{
+		Package: pattern[:index],
+		Name:    pattern[index+1:],
+		Pattern: pattern,
+	}, nil
+}
+
+// formatSelectors returns string with all selectors patterns
+func formatSelectors(selectors []*structSelector) string {
+	var result []string
+
+	for _, selector := range selectors {
+		result = append(result, selector.Pattern)
+	}
+
+	return strings.Join(result, " ")
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Match returns true if struct from package with given path matches selector
+func (s *structSelector) Match(pkgPath string, str *report.Struct) bool {
+	if s.Regexp != nil {
+		return s.Regexp.MatchString(str.Name) || s.Regexp.MatchString(pkgPath+"."+str.Name)
+	}
+
+	if ok, _ := path.Match(s.Name, str.Name); !ok {
+		return false
+	}
+
+	return s.Package == "" || inspect.MatchGlob(s.Package, pkgPath)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// selectStructs returns copy of report which contains only structs matching
+// any of given selectors
+func selectStructs(r *report.Report, selectors []*structSelector) *report.Report {
+	result := &report.Report{Meta: r.Meta, Packages: []*report.Package{}}
+
+	for _, pkg := range r.Packages {
+		var structs []*report.Struct
+
+		for _, str := range pkg.Structs {
+			for _, selector := range selectors {
+				if selector.Match(pkg.Path, str) {
+					structs = append(structs, str)
+					break
+				}
+			}
+		}
+
+		if len(structs) != 0 {
+			result.Packages = append(result.Packages, copyPackage(pkg, structs))
+		}
+	}
+
+	return result
+}
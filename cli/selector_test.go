@@ -0,0 +1,103 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		pattern string
+		pkg     string
+		name    string
+		regexp  string
+		err     bool
+	}{
+		{"Config", "", "Config", "", false},
+		{"Conf*", "", "Conf*", "", false},
+		{"example.com/a.Config", "example.com/a", "Config", "", false},
+		{"**/api.*Request", "**/api", "*Request", "", false},
+		{"/^Config$/", "", "", "^Config$", false},
+		{"/(/", "", "", "", true},
+		{"//", "", "//", "", false},
+	}
+
+	for _, tt := range tests {
+		s, err := parseSelector(tt.pattern)
+
+		if (err != nil) != tt.err {
+			t.Errorf("%q: got error %v, want error: %t", tt.pattern, err, tt.err)
+			continue
+		}
+
+		if err != nil {
+			continue
+		}
+
+		var re string
+
+		if s.Regexp != nil {
+			re = s.Regexp.String()
+		}
+
+		if s.Package != tt.pkg || s.Name != tt.name || re != tt.regexp || s.Pattern != tt.pattern {
+			t.Errorf(
+				"%q: got package=%q name=%q regexp=%q, want package=%q name=%q regexp=%q",
+				tt.pattern, s.Package, s.Name, re, tt.pkg, tt.name, tt.regexp,
+			)
+		}
+	}
+}
+
+func TestSelectStructs(t *testing.T) {
+	r := &report.Report{Packages: []*report.Package{
+		{Path: "example.com/a", Structs: []*report.Struct{{Name: "Config"}, {Name: "Client"}}},
+		{Path: "example.com/a/api", Structs: []*report.Struct{{Name: "Config"}, {Name: "GetRequest"}}},
+		{Path: "example.com/b", Structs: []*report.Struct{{Name: "Server"}}},
+	}}
+
+	tests := []struct {
+		selectors string
+		want      []string
+	}{
+		{"Config", []string{"example.com/a.Config", "example.com/a/api.Config"}},
+		{"example.com/a.Config", []string{"example.com/a.Config"}},
+		{"api.Config", []string{"example.com/a/api.Config"}},
+		{"C*", []string{"example.com/a.Config", "example.com/a.Client", "example.com/a/api.Config"}},
+		{"**/api.*Request Server", []string{"example.com/a/api.GetRequest", "example.com/b.Server"}},
+		{"/^example.com/a\\.C/", []string{"example.com/a.Config", "example.com/a.Client"}},
+		{"/Request$/", []string{"example.com/a/api.GetRequest"}},
+		{"Unknown", nil},
+	}
+
+	for _, tt := range tests {
+		selectors, err := parseSelectors(tt.selectors)
+
+		if err != nil {
+			t.Fatalf("%q: can't parse selectors: %v", tt.selectors, err)
+		}
+
+		var got []string
+
+		for _, pkg := range selectStructs(r, selectors).Packages {
+			for _, str := range pkg.Structs {
+				got = append(got, pkg.Path+"."+str.Name)
+			}
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%q: got %v, want %v", tt.selectors, got, tt.want)
+		}
+	}
+}
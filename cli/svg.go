@@ -0,0 +1,348 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Sizes used for drawing SVG diagrams
+const (
+	SVG_CELL        = 18  // size of byte cell
+	SVG_OFFSET_SIZE = 48  // width of column with offsets
+	SVG_CHAR_WIDTH  = 7   // approximate width of label character
+	SVG_TITLE_SIZE  = 48  // height of struct title
+	SVG_MARGIN      = 24  // margin between diagrams
+	SVG_CACHE_LINE  = 64  // size of CPU cache line
+	SVG_MAX_LABEL   = 48  // maximum label length
+	SVG_MIN_WIDTH   = 240 // minimum width of layout diagram
+)
+
+// SVG_PADDING_COLOR is color of padding bytes
+const SVG_PADDING_COLOR = "#e05d5d"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// svgCell contains info about single byte of struct
+type svgCell struct {
+	Field int  // index of field or -1 for unused byte
+	Pad   bool // byte is padding after field
+}
+
+// svgSpan contains info about continuous range of struct bytes
+type svgSpan struct {
+	Offset int64
+	Size   int64
+	Field  int  // index of field or -1 for unused bytes
+	Pad    bool // range is padding after field
+}
+
+// svgDiagram contains rendered diagram and its size
+type svgDiagram struct {
+	Body   string
+	Width  int
+	Height int
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printSVG prints layout diagrams of structs from report as SVG image
+func printSVG(r *report.Report) error {
+	var diagrams []*svgDiagram
+	var width, height int
+
+	for _, pkg := range r.Packages {
+		for _, str := range pkg.Structs {
+			d := drawStruct(pkg, str)
+			diagrams = append(diagrams, d)
+			width = max(width, d.Width)
+			height += d.Height + SVG_MARGIN
+		}
+	}
+
+	var buf strings.Builder
+
+	width, height = max(width+SVG_MARGIN*2, SVG_MIN_WIDTH), height+SVG_MARGIN
+
+	fmt.Fprintf(
+		&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="monospace" font-size="12">`+"\n",
+		width, height, width, height,
+	)
+
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#ffffff"/>`+"\n", width, height)
+
+	y := SVG_MARGIN
+
+	for _, d := range diagrams {
+		fmt.Fprintf(&buf, `<g transform="translate(%d,%d)">`+"\n%s</g>\n", SVG_MARGIN, y, d.Body)
+		y += d.Height + SVG_MARGIN
+	}
+
+	buf.WriteString("</svg>\n")
+
+	_, err := os.Stdout.WriteString(buf.String())
+
+	return err
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// drawStruct draws current and optimal layouts of struct
+func drawStruct(pkg *report.Package, str *report.Struct) *svgDiagram {
+	var buf strings.Builder
+
+	title := fmt.Sprintf("%s.%s — size: %d B", pkg.Path, str.Name, str.Size)
+
+	if !isAlignedStruct(str) {
+		title += fmt.Sprintf(", optimal: %d B", str.OptimalSize)
+	}
+
+	fmt.Fprintf(&buf, `<text x="0" y="14" font-size="14" font-weight="bold">%s</text>`+"\n", svgEscape(title))
+
+	current := drawLayout("Current order", str.Fields)
+	width, height := current.Width, current.Height
+
+	fmt.Fprintf(&buf, `<g transform="translate(0,%d)">`+"\n%s</g>\n", SVG_TITLE_SIZE-20, current.Body)
+
+	if !isAlignedStruct(str) && str.AlignedFields != nil {
+		optimal := drawLayout("Optimal order", str.AlignedFields)
+
+		fmt.Fprintf(
+			&buf, `<g transform="translate(%d,%d)">`+"\n%s</g>\n",
+			current.Width+SVG_MARGIN, SVG_TITLE_SIZE-20, optimal.Body,
+		)
+
+		width += SVG_MARGIN + optimal.Width
+		height = max(height, optimal.Height)
+	}
+
+	return &svgDiagram{
+		Body:   buf.String(),
+		Width:  max(width, len(title)*SVG_CHAR_WIDTH),
+		Height: height + SVG_TITLE_SIZE - 20,
+	}
+}
+
+// drawLayout draws byte map of fields
+func drawLayout(title string, fields []*report.Field) *svgDiagram {
+	var buf strings.Builder
+
+	maxAlign := inspect.GetMaxAlign()
+	spans, size := getSVGSpans(fields)
+	labels := map[int64][]string{}
+	labelSize := 0
+
+	for _, f := range fields {
+		row := f.Offset / maxAlign
+		labels[row] = append(labels[row], fmt.Sprintf("%s %s", f.Name, f.Type))
+	}
+
+	for row, l := range labels {
+		label := []rune(strings.Join(l, ", "))
+
+		if len(label) > SVG_MAX_LABEL {
+			label = append(label[:SVG_MAX_LABEL-1], '…')
+		}
+
+		labels[row] = []string{string(label)}
+		labelSize = max(labelSize, len(label))
+	}
+
+	gridWidth := int(maxAlign) * SVG_CELL
+	labelX := SVG_OFFSET_SIZE + gridWidth + 8
+	width := max(labelX+labelSize*SVG_CHAR_WIDTH, SVG_MIN_WIDTH)
+
+	fmt.Fprintf(&buf, `<text x="0" y="12" fill="#777777">%s</text>`+"\n", svgEscape(title))
+
+	y := 20
+	rows := (size + maxAlign - 1) / maxAlign
+
+	for row := int64(0); row < rows; row++ {
+		start := row * maxAlign
+
+		// Rows which are fully inside of large field beyond MAX_FIELD_SIZE
+		// are drawn as one scaled span
+		if span := findSVGSpan(spans, start); isDeepSpan(span, start, maxAlign) {
+			lastRow := (span.Offset+span.Size)/maxAlign - 1
+
+			drawDeepSpan(&buf, y, gridWidth, (lastRow-row+1)*maxAlign, span.Field, fields)
+
+			row = lastRow
+			y += SVG_CELL
+
+			continue
+		}
+
+		fmt.Fprintf(
+			&buf, `<text x="%d" y="%d" text-anchor="end" fill="#777777">%d</text>`+"\n",
+			SVG_OFFSET_SIZE-8, y+SVG_CELL-5, start,
+		)
+
+		for i := int64(0); i < maxAlign && start+i < size; i++ {
+			drawCell(&buf, SVG_OFFSET_SIZE+int(i)*SVG_CELL, y, getSVGCell(spans, start+i), fields)
+		}
+
+		if len(labels[row]) != 0 {
+			fmt.Fprintf(
+				&buf, `<text x="%d" y="%d">%s</text>`+"\n",
+				labelX, y+SVG_CELL-5, svgEscape(labels[row][0]),
+			)
+		}
+
+		y += SVG_CELL
+
+		if (row+1)*maxAlign%SVG_CACHE_LINE == 0 && row+1 < rows {
+			fmt.Fprintf(
+				&buf, `<line x1="0" y1="%d" x2="%d" y2="%d" stroke="#3b7dd8" stroke-dasharray="4 3"/>`+"\n",
+				y+1, SVG_OFFSET_SIZE+gridWidth, y+1,
+			)
+
+			y += 3
+		}
+	}
+
+	if size == 0 {
+		fmt.Fprintf(&buf, `<text x="%d" y="%d" fill="#777777">0 B</text>`+"\n", SVG_OFFSET_SIZE, y+SVG_CELL-5)
+		y += SVG_CELL
+	}
+
+	return &svgDiagram{Body: buf.String(), Width: width, Height: y}
+}
+
+// drawCell draws single byte cell
+func drawCell(buf *strings.Builder, x, y int, cell svgCell, fields []*report.Field) {
+	var color, title string
+
+	switch {
+	case cell.Field < 0:
+		color, title = "#eeeeee", "unused"
+	case cell.Pad:
+		color = SVG_PADDING_COLOR
+		title = fmt.Sprintf("padding after %s (%d B)", fields[cell.Field].Name, fields[cell.Field].Padding)
+	default:
+		f := fields[cell.Field]
+		color = getSVGFieldColor(cell.Field)
+		title = fmt.Sprintf("%s %s (offset: %d, size: %d)", f.Name, f.Type, f.Offset, f.Size)
+	}
+
+	fmt.Fprintf(
+		buf, `<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s" stroke="#ffffff"><title>%s</title></rect>`+"\n",
+		x, y, SVG_CELL, SVG_CELL, color, svgEscape(title),
+	)
+}
+
+// drawDeepSpan draws collapsed rows of large field
+func drawDeepSpan(buf *strings.Builder, y, width int, size int64, field int, fields []*report.Field) {
+	f := fields[field]
+	title := fmt.Sprintf("%s %s (offset: %d, size: %d)", f.Name, f.Type, f.Offset, f.Size)
+
+	fmt.Fprintf(
+		buf, `<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s" fill-opacity="0.4" stroke="#ffffff"><title>%s</title></rect>`+"\n",
+		SVG_OFFSET_SIZE, y, width, SVG_CELL, getSVGFieldColor(field), svgEscape(title),
+	)
+
+	fmt.Fprintf(
+		buf, `<text x="%d" y="%d" fill="#555555">⋯ +%d B</text>`+"\n",
+		SVG_OFFSET_SIZE+4, y+SVG_CELL-5, size,
+	)
+}
+
+// getSVGSpans returns sorted by offset ranges of struct bytes occupied by
+// fields, padding and unused bytes, and size of struct
+func getSVGSpans(fields []*report.Field) ([]svgSpan, int64) {
+	var spans []svgSpan
+	var pos int64
+
+	order := make([]int, len(fields))
+
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, func(a, b int) int {
+		return int(fields[a].Offset - fields[b].Offset)
+	})
+
+	for _, i := range order {
+		f := fields[i]
+
+		if f.Offset > pos {
+			spans = append(spans, svgSpan{Offset: pos, Size: f.Offset - pos, Field: -1})
+		}
+
+		if f.Size > 0 {
+			spans = append(spans, svgSpan{Offset: f.Offset, Size: f.Size, Field: i})
+		}
+
+		if f.Padding > 0 {
+			spans = append(spans, svgSpan{Offset: f.Offset + f.Size, Size: f.Padding, Field: i, Pad: true})
+		}
+
+		pos = max(pos, f.Offset+f.Size+f.Padding)
+	}
+
+	return spans, pos
+}
+
+// findSVGSpan returns span which contains byte with given offset
+func findSVGSpan(spans []svgSpan, offset int64) *svgSpan {
+	i, _ := slices.BinarySearchFunc(spans, offset, func(s svgSpan, offset int64) int {
+		switch {
+		case s.Offset+s.Size <= offset:
+			return -1
+		case s.Offset > offset:
+			return 1
+		}
+
+		return 0
+	})
+
+	if i < len(spans) && spans[i].Offset <= offset && offset < spans[i].Offset+spans[i].Size {
+		return &spans[i]
+	}
+
+	return nil
+}
+
+// getSVGCell returns info about byte with given offset
+func getSVGCell(spans []svgSpan, offset int64) svgCell {
+	span := findSVGSpan(spans, offset)
+
+	if span == nil {
+		return svgCell{Field: -1}
+	}
+
+	return svgCell{Field: span.Field, Pad: span.Pad}
+}
+
+// isDeepSpan returns true if row with given start is fully inside of field
+// span and beyond MAX_FIELD_SIZE
+func isDeepSpan(span *svgSpan, start, rowSize int64) bool {
+	return span != nil && span.Field >= 0 && !span.Pad &&
+		start-span.Offset >= MAX_FIELD_SIZE &&
+		start+rowSize <= span.Offset+span.Size
+}
+
+// getSVGFieldColor returns color of field with given index
+func getSVGFieldColor(field int) string {
+	return fmt.Sprintf("hsl(%d,60%%,62%%)", field*47%360)
+}
+
+// svgEscape escapes text for using in SVG
+func svgEscape(text string) string {
+	return html.EscapeString(text)
+}
@@ -0,0 +1,95 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestPrintSVG(t *testing.T) {
+	r := getTestReport("/src/module")
+	r.Packages[0].Path = "example.com/R&D"
+
+	out := captureOutput(t, func() error { return printSVG(r) })
+
+	if strings.Contains(out, "chan<-") || strings.Contains(out, "R&D") {
+		t.Errorf("output contains unescaped characters")
+	}
+
+	texts, titles, padding := map[string]int{}, map[string]int{}, 0
+	dec := xml.NewDecoder(strings.NewReader(out))
+
+	var elements []string
+
+	for {
+		token, err := dec.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("output is not valid SVG: %v", err)
+		}
+
+		switch tok := token.(type) {
+		case xml.StartElement:
+			elements = append(elements, tok.Name.Local)
+
+			for _, attr := range tok.Attr {
+				if tok.Name.Local == "rect" && attr.Name.Local == "fill" && attr.Value == SVG_PADDING_COLOR {
+					padding++
+				}
+			}
+		case xml.EndElement:
+			elements = elements[:len(elements)-1]
+		case xml.CharData:
+			switch {
+			case len(elements) == 0:
+				continue
+			case elements[len(elements)-1] == "text":
+				texts[string(tok)]++
+			case elements[len(elements)-1] == "title":
+				titles[string(tok)]++
+			}
+		}
+	}
+
+	tests := []struct {
+		name  string
+		items map[string]int
+		text  string
+		want  int
+	}{
+		{"padded title", texts, "example.com/R&D.Request — size: 24 B, optimal: 16 B", 1},
+		{"budget title", texts, "example.com/R&D.Limited — size: 16 B", 1},
+		{"aligned title", texts, "example.com/R&D.Aligned — size: 8 B", 1},
+		{"current order", texts, "Current order", 3},
+		{"optimal order", texts, "Optimal order", 1},
+		{"field label", texts, "Ch chan<- bool", 2},
+		{"row offset", texts, "16", 2},
+		{"field cell", titles, "Ch chan<- bool (offset: 16, size: 8)", 8},
+		{"optimal field cell", titles, "Ch chan<- bool (offset: 8, size: 8)", 8},
+		{"padding cell", titles, "padding after Ready (7 B)", 14},
+	}
+
+	for _, tt := range tests {
+		if got := tt.items[tt.text]; got != tt.want {
+			t.Errorf("%s: got %q %d times, want %d", tt.name, tt.text, got, tt.want)
+		}
+	}
+
+	if padding != 14 {
+		t.Errorf("got %d padding cells, want 14", padding)
+	}
+}
@@ -0,0 +1,181 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type checkstyleReport struct {
+	XMLName xml.Name          `xml:"checkstyle"`
+	Version string            `xml:"version,attr"`
+	Files   []*checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string             `xml:"name,attr"`
+	Errors []*checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+}
+
+type junitTestSuite struct {
+	Name     string           `xml:"name,attr"`
+	Cases    []*junitTestCase `xml:"testcase"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+}
+
+type junitTestCase struct {
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr"`
+	Line      int           `xml:"line,attr"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",cdata"`
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// printCheckstyle prints problems from report in checkstyle XML format
+func printCheckstyle(r *report.Report, root string) error {
+	result := &checkstyleReport{Version: "4.3"}
+	files := map[string]*checkstyleFile{}
+
+	for _, p := range getProblems(r) {
+		path := getRelativePath(root, p.Struct.Position.Path)
+
+		if files[path] == nil {
+			files[path] = &checkstyleFile{Name: path}
+			result.Files = append(result.Files, files[path])
+		}
+
+		files[path].Errors = append(files[path].Errors, &checkstyleError{
+			Severity: "warning",
+			Message:  p.Message,
+			Source:   APP + "." + p.Rule,
+			Line:     p.Struct.Position.Line,
+			Column:   p.Struct.Position.Column,
+		})
+	}
+
+	return printXML(result)
+}
+
+// printJUnit prints report in JUnit XML format where every package is a test
+// suite and every struct is a test case
+func printJUnit(r *report.Report, root string) error {
+	result := &junitTestSuites{Name: APP}
+	problems := map[*report.Struct][]*problem{}
+
+	for _, p := range getProblems(r) {
+		problems[p.Struct] = append(problems[p.Struct], p)
+	}
+
+	for _, pkg := range r.Packages {
+		if pkg.IsEmpty() {
+			continue
+		}
+
+		suite := &junitTestSuite{Name: pkg.Path}
+
+		for _, str := range pkg.Structs {
+			testCase := &junitTestCase{
+				Name:      str.Name,
+				ClassName: pkg.Path,
+				File:      getRelativePath(root, str.Position.Path),
+				Line:      str.Position.Line,
+			}
+
+			if len(problems[str]) != 0 {
+				testCase.Failure = getJUnitFailure(root, str, problems[str])
+				suite.Failures++
+			}
+
+			suite.Cases = append(suite.Cases, testCase)
+			suite.Tests++
+		}
+
+		result.Suites = append(result.Suites, suite)
+		result.Tests += suite.Tests
+		result.Failures += suite.Failures
+	}
+
+	return printXML(result)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getJUnitFailure returns failure info for struct with problems
+func getJUnitFailure(root string, str *report.Struct, problems []*problem) *junitFailure {
+	var messages []string
+
+	for _, p := range problems {
+		messages = append(messages, p.Message)
+	}
+
+	text := fmt.Sprintf(
+		"%s:%d\nSize: %d, optimal: %d\n\n%s",
+		getRelativePath(root, str.Position.Path), str.Position.Line,
+		str.Size, str.OptimalSize, strings.Join(messages, "\n"),
+	)
+
+	if str.Suggestion != "" && !isAlignedStruct(str) {
+		text += "\n\nOptimal order:\n\ntype " + str.Name + " " + str.Suggestion
+	}
+
+	return &junitFailure{
+		Message: messages[0],
+		Type:    problems[0].Rule,
+		Text:    text + "\n",
+	}
+}
+
+// printXML prints given data as indented XML document
+func printXML(data any) error {
+	os.Stdout.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(os.Stdout)
+	enc.Indent("", "  ")
+
+	err := enc.Encode(data)
+
+	if err != nil {
+		return err
+	}
+
+	os.Stdout.WriteString("\n")
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestPrintCheckstyle(t *testing.T) {
+	tests := []struct {
+		name string
+		r    *report.Report
+		root string
+		want string
+	}{
+		{
+			"relative paths",
+			getTestReport("/src/R&D"),
+			"/src/R&D",
+			`<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="api/types.go">
+    <error severity="warning" message="Struct Request fields order can be optimized (24 → 16)" source="aligo.alignment" line="3" column="1"></error>
+    <error severity="warning" message="Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B" source="aligo.max-size" line="9" column="1"></error>
+  </file>
+</checkstyle>
+`,
+		},
+		{
+			"escaped absolute paths",
+			getTestReport("/src/R&D"),
+			"",
+			`<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="/src/R&amp;D/api/types.go">
+    <error severity="warning" message="Struct Request fields order can be optimized (24 → 16)" source="aligo.alignment" line="3" column="1"></error>
+    <error severity="warning" message="Struct Limited: Struct size 16 exceeds budget 8 on amd64, fields over budget: B" source="aligo.max-size" line="9" column="1"></error>
+  </file>
+</checkstyle>
+`,
+		},
+		{
+			"no problems",
+			&report.Report{Packages: []*report.Package{{Path: "example.com/test"}}},
+			"/src/R&D",
+			`<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3"></checkstyle>
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		out := captureOutput(t, func() error { return printCheckstyle(tt.r, tt.root) })
+
+		if out != tt.want {
+			t.Errorf("%s: got output:\n%s\nwant:\n%s", tt.name, out, tt.want)
+		}
+	}
+}
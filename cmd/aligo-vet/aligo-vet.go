@@ -0,0 +1,20 @@
+package main
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/essentialkaos/aligo/v2/analyzer"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func main() {
+	singlechecker.Main(analyzer.Analyzer)
+}
@@ -3,8 +3,10 @@ module github.com/essentialkaos/aligo/v2
 go 1.25.0
 
 require (
+	github.com/BurntSushi/toml v1.6.0
 	github.com/essentialkaos/ek/v14 v14.2.1
 	github.com/kisielk/gotool v1.0.0
+	go.yaml.in/yaml/v3 v3.0.5
 	golang.org/x/tools v0.46.0
 )
 
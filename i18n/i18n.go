@@ -21,8 +21,9 @@ type Text = i18n.Text
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 type I18NBundle struct {
-	INFO  *I18NInfo
-	USAGE *I18NUsage
+	INFO     *I18NInfo
+	WARNINGS *I18NWarnings
+	USAGE    *I18NUsage
 
 	ERRORS *I18NErrors
 }
@@ -31,6 +32,22 @@ type I18NErrors struct {
 	OPTION_PARSING      Text
 	UNSUPPORTED_COMMAND Text
 	UNKNOWN_ARCH        Text
+	UNSUPPORTED_FORMAT  Text
+	CANT_READ_REPORT    Text
+	INPUT_CONFLICT      Text
+	CANT_READ_BASELINE  Text
+	GIT_ERROR           Text
+	COMPARE_ARGS        Text
+	COMPARE_MIXED       Text
+	COMPARE_ARCHS       Text
+	CANT_READ_LOCK      Text
+	LOCK_EXISTS         Text
+	LOCK_ARCHS          Text
+	LOCK_REMOVED        Text
+	CANT_READ_CONFIG    Text
+	UNKNOWN_CONFIG_KEYS Text
+	FILE_EXISTS         Text
+	INVALID_REGEXP      Text
 
 	EMPTY_STRUCT_NAME Text
 	NO_STRUCT         Text
@@ -39,10 +56,45 @@ type I18NErrors struct {
 }
 
 type I18NInfo struct {
-	ALL_OPTIMAL     Text
-	OPTIMIZE_ADVICE Text
-	WITH_OPTIMAL    Text
-	ALREADY_OPTIMAL Text
+	ALL_OPTIMAL         Text
+	ALL_OPTIMAL_SKIPPED Text
+	OPTIMIZE_ADVICE     Text
+	WITH_OPTIMAL        Text
+	ALREADY_OPTIMAL     Text
+	FIXED_LAYOUT        Text
+	HAS_WARNINGS        Text
+
+	ANNOTATIONS_UPDATED    Text
+	ANNOTATIONS_REMOVED    Text
+	ANNOTATIONS_UP_TO_DATE Text
+	BASELINE_SAVED         Text
+
+	NO_CHANGES      Text
+	CHANGES_GREW    Text
+	CHANGES_SHRANK  Text
+	CHANGES_ADDED   Text
+	CHANGES_REMOVED Text
+
+	LOCK_SAVED Text
+
+	GENERATED_SKIPPED Text
+
+	CONFIG_SAVED     Text
+	WORKFLOW_SAVED   Text
+	WORKFLOW_SNIPPET Text
+}
+
+type I18NWarnings struct {
+	ALIGNMENT      Text
+	STRUCT_WARNING Text
+	ABI_OFFSET     Text
+	ABI_SIZE       Text
+	ABI_ZERO_TAIL  Text
+	SERIALIZATION  Text
+	MAX_SIZE       Text
+	BUDGET_ARCH    Text
+	LOCKED_SIZE    Text
+	NOT_LOCKED     Text
 }
 
 type I18NUsage struct {
@@ -64,31 +116,77 @@ type I18NUsage struct {
 }
 
 type I18NCommands struct {
-	CHECK Text
-	VIEW  Text
+	CHECK    Text
+	VIEW     Text
+	ANNOTATE Text
+	COMPARE  Text
+	LOCK     Text
+	INIT     Text
+	LSP      Text
 }
 
 type I18NOptions struct {
-	ARCH        Text
-	ARCH_VAL    Text
-	STRUCT      Text
-	STRUCT_VAL  Text
-	TAGS        Text
-	TAGS_VAL    Text
-	PAGER       Text
-	EXCLUDE     Text
-	EXCLUDE_VAL Text
-	NO_COLOR    Text
-	HELP        Text
-	VER         Text
+	ARCH          Text
+	ARCH_VAL      Text
+	STRUCT        Text
+	STRUCT_VAL    Text
+	TAGS          Text
+	TAGS_VAL      Text
+	PAGER         Text
+	EXCLUDE       Text
+	EXCLUDE_VAL   Text
+	INCLUDE       Text
+	EXCL_STRUCT   Text
+	INCL_STRUCT   Text
+	REGEXP_VAL    Text
+	INCLUDE_ABI   Text
+	KEEP_ORDER    Text
+	INCLUDE_GEN   Text
+	NO_ABI        Text
+	NO_KEEP_ORDER Text
+	NO_GEN        Text
+	STRIP         Text
+	FORMAT        Text
+	FORMAT_VAL    Text
+	FIELDS        Text
+	ABSOLUTE      Text
+	INPUT         Text
+	INPUT_VAL     Text
+	BASELINE      Text
+	BASELINE_VAL  Text
+	WRITE_BASE    Text
+	NEW_REV       Text
+	NEW_REV_VAL   Text
+	NEW_PATCH     Text
+	NEW_PATCH_VAL Text
+	THRESHOLD     Text
+	THRESHOLD_VAL Text
+	LOCKED        Text
+	LOCK_FILE     Text
+	LOCK_FILE_VAL Text
+	UPDATE        Text
+	WORKFLOW      Text
+	NO_COLOR      Text
+	HELP          Text
+	VER           Text
 }
 
 type I18NExamples struct {
-	EXAMPLE_1 Text
-	EXAMPLE_2 Text
-	EXAMPLE_3 Text
-	EXAMPLE_4 Text
-	EXAMPLE_5 Text
+	EXAMPLE_1  Text
+	EXAMPLE_2  Text
+	EXAMPLE_3  Text
+	EXAMPLE_4  Text
+	EXAMPLE_5  Text
+	EXAMPLE_6  Text
+	EXAMPLE_7  Text
+	EXAMPLE_8  Text
+	EXAMPLE_9  Text
+	EXAMPLE_10 Text
+	EXAMPLE_11 Text
+	EXAMPLE_12 Text
+	EXAMPLE_13 Text
+	EXAMPLE_14 Text
+	EXAMPLE_15 Text
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -115,19 +213,70 @@ func SetLanguage() {
 func getEN() *I18NBundle {
 	return &I18NBundle{
 		INFO: &I18NInfo{
-			ALL_OPTIMAL:     "{g}All structs are well aligned{!}",
-			OPTIMIZE_ADVICE: "Struct {*}%s{!} {s-}(%s:%d){!} fields order can be optimized (%d → %d)",
-			WITH_OPTIMAL:    "{s-}// %s:%d | Size: %d (Optimal: %d){!}",
-			ALREADY_OPTIMAL: "{s-}// %s:%d | Size: %d{!}",
+			ALL_OPTIMAL:         "{g}All structs are well aligned{!}",
+			ALL_OPTIMAL_SKIPPED: "{g}All structs are well aligned{!} {s-}(skipped structs from generated files: %d){!}",
+			OPTIMIZE_ADVICE:     "Struct {*}%s{!} {s-}(%s:%d){!} fields order can be optimized (%d → %d)",
+			WITH_OPTIMAL:        "{s-}// %s:%d | Size: %d (Optimal: %d){!}",
+			ALREADY_OPTIMAL:     "{s-}// %s:%d | Size: %d{!}",
+			FIXED_LAYOUT:        "{s-}// %s:%d | Size: %d | Fixed layout{!}",
+			HAS_WARNINGS:        "Struct {*}%s{!} {s-}(%s:%d){!} has layout warnings",
+
+			ANNOTATIONS_UPDATED:    "{g}✔ {!}Annotations updated in {*}%s{!}",
+			ANNOTATIONS_REMOVED:    "{g}✔ {!}Annotations removed from {*}%s{!}",
+			ANNOTATIONS_UP_TO_DATE: "{g}All annotations are up to date{!}",
+			BASELINE_SAVED:         "{g}Baseline with %d structs saved to {*}%s{!}",
+
+			NO_CHANGES:      "{g}Sizes of structs are not changed{!}",
+			CHANGES_GREW:    "Grew",
+			CHANGES_SHRANK:  "Shrank",
+			CHANGES_ADDED:   "Added",
+			CHANGES_REMOVED: "Removed",
+
+			LOCK_SAVED: "{g}Sizes of %d structs saved to {*}%s{!}",
+
+			GENERATED_SKIPPED: "{s-}Skipped structs from generated files: %d (use --include-generated option for checking them){!}",
+
+			CONFIG_SAVED:     "{g}Configuration saved to {*}%s{!}",
+			WORKFLOW_SAVED:   "{g}GitHub Actions workflow saved to {*}%s{!}",
+			WORKFLOW_SNIPPET: "Add this workflow to {*}%s{!} for checking sources on GitHub Actions:",
+		},
+
+		WARNINGS: &I18NWarnings{
+			ABI_OFFSET:     "Field {*}%s{!} has different offsets in Go and C layouts on %s",
+			ABI_SIZE:       "Struct has different sizes in Go and C layouts on %s",
+			ABI_ZERO_TAIL:  "Trailing zero-size field {*}%s{!} adds Go-specific padding on %s",
+			SERIALIZATION:  "Reordering changes order of fields in {*}%s{!} output",
+			MAX_SIZE:       "Struct size {*}%d{!} exceeds budget {*}%d{!} on %s, fields over budget: {*}%s{!}",
+			BUDGET_ARCH:    "Unknown architecture {*}%s{!} in size budget directive",
+			LOCKED_SIZE:    "Struct size changed from {*}%d{!} to {*}%d{!} on %s",
+			NOT_LOCKED:     "Struct is not in lock file",
+			ALIGNMENT:      "Struct {*}%s{!} fields order can be optimized (%d → %d)",
+			STRUCT_WARNING: "Struct {*}%s{!}: %s",
 		},
 
 		ERRORS: &I18NErrors{
 			OPTION_PARSING:      "Options parsing errors",
 			UNSUPPORTED_COMMAND: "Command %s is unsupported",
 			UNKNOWN_ARCH:        "Unknown arch %s",
+			UNSUPPORTED_FORMAT:  "Output format %q is unsupported",
+			CANT_READ_REPORT:    "Can't read report from %s: %v",
+			INPUT_CONFLICT:      "%s can't be used with saved report (--input)",
+			CANT_READ_BASELINE:  "Can't read baseline from %s: %v",
+			GIT_ERROR:           "Can't execute \"git %s\": %s",
+			COMPARE_ARGS:        "You should define two reports or two git revisions for comparison",
+			COMPARE_MIXED:       "Can't compare report with git revision (%s and %s)",
+			COMPARE_ARCHS:       "Reports don't have common architectures (%s and %s)",
+			CANT_READ_LOCK:      "Can't read lock file %s: %v",
+			LOCK_EXISTS:         "Lock file %s already exists, use --update option for updating it",
+			LOCK_ARCHS:          "Architectures %s don't match architectures %s from lock file %s, remove lock file for changing them",
+			LOCK_REMOVED:        "Struct %s from lock file %s doesn't exist anymore",
+			CANT_READ_CONFIG:    "Can't read configuration from %s: %v",
+			UNKNOWN_CONFIG_KEYS: "Configuration %s contains unknown keys: %s",
+			FILE_EXISTS:         "File %s already exists, use --update option for overwriting it",
+			INVALID_REGEXP:      "Invalid regular expression %q: %v",
 
 			NO_ANY_STRUCTS:    "Given package doesn't have any structs",
-			NO_STRUCT:         "Can't find struct with name %q",
+			NO_STRUCT:         "Can't find structs matching %q",
 			EMPTY_STRUCT_NAME: "You should define struct name",
 			NO_IMPORT_PATHS:   "No import paths found",
 		},
@@ -146,31 +295,77 @@ func getEN() *I18NBundle {
 			LICENSE:             "Apache License, Version 2.0",
 
 			COMMANDS: &I18NCommands{
-				CHECK: "Check package for alignment problems",
-				VIEW:  "Print alignment info for all structs",
+				CHECK:    "Check package for alignment problems",
+				VIEW:     "Print alignment info for all structs",
+				ANNOTATE: "Add or update comments with fields offsets and struct sizes",
+				COMPARE:  "Compare structs sizes in two reports or git revisions",
+				LOCK:     "Save structs sizes to lock file",
+				INIT:     "Create configuration, baseline and CI workflow for module",
+				LSP:      "Start language server {s-}(stdio){!}",
 			},
 
 			OPTIONS: &I18NOptions{
-				ARCH:        "Architecture name",
-				ARCH_VAL:    "name",
-				STRUCT:      "Print info only about struct with given name",
-				STRUCT_VAL:  "name",
-				TAGS:        "Build tags {s-}(mergeble){!}",
-				TAGS_VAL:    "tag…",
-				PAGER:       "Use pager for long output",
-				EXCLUDE:     "Exclude packages containing given pattern {s-}(mergeble){!}",
-				EXCLUDE_VAL: "pattern…",
-				NO_COLOR:    "Disable colors in output",
-				HELP:        "Show this help message",
-				VER:         "Show version",
+				ARCH:          "Architecture name {s-}(mergeble){!}",
+				ARCH_VAL:      "name…",
+				STRUCT:        "Print info only about structs with given names or patterns {s-}(mergeble){!}",
+				STRUCT_VAL:    "name…",
+				TAGS:          "Build tags {s-}(mergeble){!}",
+				TAGS_VAL:      "tag…",
+				PAGER:         "Use pager for long output",
+				EXCLUDE:       "Exclude packages and files matching given glob pattern {s-}(mergeble){!}",
+				EXCLUDE_VAL:   "pattern…",
+				INCLUDE:       "Check only packages and files matching given glob pattern {s-}(mergeble){!}",
+				EXCL_STRUCT:   "Exclude structs with names matching given regexp {s-}(mergeble){!}",
+				INCL_STRUCT:   "Check only structs with names matching given regexp {s-}(mergeble){!}",
+				REGEXP_VAL:    "regexp…",
+				INCLUDE_ABI:   "Show optimization advice for structs with fixed layout",
+				KEEP_ORDER:    "Keep original order of serialized fields while optimizing",
+				INCLUDE_GEN:   "Check structs from generated files",
+				NO_ABI:        "Don't show optimization advice for structs with fixed layout",
+				NO_KEEP_ORDER: "Don't keep original order of serialized fields while optimizing",
+				NO_GEN:        "Skip structs from generated files",
+				STRIP:         "Remove annotations added by annotate command",
+				FORMAT:        "Output format {s-}(text|json|sarif|checkstyle|junit|github|markdown|html|svg|csv|tsv|line){!}",
+				FORMAT_VAL:    "format",
+				FIELDS:        "Print row for every field in CSV and TSV output",
+				ABSOLUTE:      "Print absolute paths to files in line output",
+				INPUT:         "Read saved JSON report instead of analyzing sources",
+				INPUT_VAL:     "file",
+				BASELINE:      "Ignore known problems from baseline file",
+				BASELINE_VAL:  "file",
+				WRITE_BASE:    "Write current problems to baseline file",
+				NEW_REV:       "Check only structs changed since merge base with given git revision",
+				NEW_REV_VAL:   "rev",
+				NEW_PATCH:     "Check only structs changed by given patch",
+				NEW_PATCH_VAL: "file",
+				THRESHOLD:     "Maximum allowed struct growth in bytes for compare command",
+				THRESHOLD_VAL: "bytes",
+				LOCKED:        "Check structs sizes against lock file",
+				LOCK_FILE:     "Path to lock file {s-}(default: aligo.lock){!}",
+				LOCK_FILE_VAL: "file",
+				UPDATE:        "Update existing lock file or files created by init command",
+				WORKFLOW:      "Write CI workflow to file instead of printing it",
+				NO_COLOR:      "Disable colors in output",
+				HELP:          "Show this help message",
+				VER:           "Show version",
 			},
 
 			EXAMPLES: &I18NExamples{
-				EXAMPLE_1: "Show info about all structs in current package",
-				EXAMPLE_2: "Check current package",
-				EXAMPLE_3: "Check current package and all sub-packages",
-				EXAMPLE_4: "Check current package and all sub-packages with custom build tags",
-				EXAMPLE_5: "Show info about PostMessageParameters struct",
+				EXAMPLE_1:  "Show info about all structs in current package",
+				EXAMPLE_2:  "Check current package",
+				EXAMPLE_3:  "Check current package and all sub-packages",
+				EXAMPLE_4:  "Check current package and all sub-packages with custom build tags",
+				EXAMPLE_5:  "Show info about PostMessageParameters struct",
+				EXAMPLE_6:  "Add comments with layout info to all structs",
+				EXAMPLE_7:  "Check current package and all sub-packages and print problems as JSON",
+				EXAMPLE_8:  "Check report saved in JSON format",
+				EXAMPLE_9:  "Check current package and all sub-packages and fail only on new problems",
+				EXAMPLE_10: "Check only structs changed since origin/master",
+				EXAMPLE_11: "Compare structs sizes in v1.0.0 and HEAD and fail if any struct grew by more than 8 bytes",
+				EXAMPLE_12: "Save sizes of structs on amd64 and arm64 to lock file",
+				EXAMPLE_13: "Check current package and all sub-packages and fail if structs sizes differ from lock file",
+				EXAMPLE_14: "Create configuration, baseline with current problems and GitHub Actions workflow",
+				EXAMPLE_15: "Show info about Config struct from api package and all structs with names starting with Mock",
 			},
 		},
 	}
@@ -180,19 +375,70 @@ func getEN() *I18NBundle {
 func getRU() *I18NBundle {
 	return &I18NBundle{
 		INFO: &I18NInfo{
-			ALL_OPTIMAL:     "{g}Проблем с выравниванием структур не обнаружено{!}",
-			OPTIMIZE_ADVICE: "Поля структуры {*}%s{!} {s-}(%s:%d){!} могут быть оптимизированны (%d → %d)",
-			WITH_OPTIMAL:    "{s-}// %s:%d | Размер: %d (Оптимальный: %d){!}",
-			ALREADY_OPTIMAL: "{s-}// %s:%d | Размер: %d{!}",
+			ALL_OPTIMAL:         "{g}Проблем с выравниванием структур не обнаружено{!}",
+			ALL_OPTIMAL_SKIPPED: "{g}Проблем с выравниванием структур не обнаружено{!} {s-}(пропущено структур из сгенерированных файлов: %d){!}",
+			OPTIMIZE_ADVICE:     "Поля структуры {*}%s{!} {s-}(%s:%d){!} могут быть оптимизированны (%d → %d)",
+			WITH_OPTIMAL:        "{s-}// %s:%d | Размер: %d (Оптимальный: %d){!}",
+			ALREADY_OPTIMAL:     "{s-}// %s:%d | Размер: %d{!}",
+			FIXED_LAYOUT:        "{s-}// %s:%d | Размер: %d | Фиксированная раскладка{!}",
+			HAS_WARNINGS:        "Для структуры {*}%s{!} {s-}(%s:%d){!} есть предупреждения о раскладке",
+
+			ANNOTATIONS_UPDATED:    "{g}✔ {!}Аннотации обновлены в {*}%s{!}",
+			ANNOTATIONS_REMOVED:    "{g}✔ {!}Аннотации удалены из {*}%s{!}",
+			ANNOTATIONS_UP_TO_DATE: "{g}Все аннотации актуальны{!}",
+			BASELINE_SAVED:         "{g}Базовый уровень с %d структурами сохранён в {*}%s{!}",
+
+			NO_CHANGES:      "{g}Размеры структур не изменились{!}",
+			CHANGES_GREW:    "Увеличились",
+			CHANGES_SHRANK:  "Уменьшились",
+			CHANGES_ADDED:   "Добавлены",
+			CHANGES_REMOVED: "Удалены",
+
+			LOCK_SAVED: "{g}Размеры %d структур сохранены в {*}%s{!}",
+
+			GENERATED_SKIPPED: "{s-}Пропущено структур из сгенерированных файлов: %d (используйте опцию --include-generated для их проверки){!}",
+
+			CONFIG_SAVED:     "{g}Конфигурация сохранена в {*}%s{!}",
+			WORKFLOW_SAVED:   "{g}Рабочий процесс GitHub Actions сохранён в {*}%s{!}",
+			WORKFLOW_SNIPPET: "Добавьте этот рабочий процесс в {*}%s{!} для проверки исходного кода в GitHub Actions:",
+		},
+
+		WARNINGS: &I18NWarnings{
+			ABI_OFFSET:     "Поле {*}%s{!} имеет разные смещения в раскладках Go и C на %s",
+			ABI_SIZE:       "Структура имеет разный размер в раскладках Go и C на %s",
+			ABI_ZERO_TAIL:  "Последнее поле нулевого размера {*}%s{!} добавляет специфичное для Go выравнивание на %s",
+			SERIALIZATION:  "Изменение порядка полей изменит порядок полей в выводе {*}%s{!}",
+			MAX_SIZE:       "Размер структуры {*}%d{!} превышает лимит {*}%d{!} на %s, поля за пределами лимита: {*}%s{!}",
+			BUDGET_ARCH:    "Неизвестная архитектура {*}%s{!} в директиве лимита размера",
+			LOCKED_SIZE:    "Размер структуры изменился с {*}%d{!} на {*}%d{!} на %s",
+			NOT_LOCKED:     "Структура отсутствует в файле блокировки",
+			ALIGNMENT:      "Поля структуры {*}%s{!} могут быть оптимизированны (%d → %d)",
+			STRUCT_WARNING: "Структура {*}%s{!}: %s",
 		},
 
 		ERRORS: &I18NErrors{
 			OPTION_PARSING:      "Ошибки обработки опций",
 			UNSUPPORTED_COMMAND: "Команда %s не поддерживается",
 			UNKNOWN_ARCH:        "Неизвестная архитектура %s",
+			UNSUPPORTED_FORMAT:  "Формат вывода %q не поддерживается",
+			CANT_READ_REPORT:    "Не удалось прочитать отчёт из %s: %v",
+			INPUT_CONFLICT:      "%s нельзя использовать с сохранённым отчётом (--input)",
+			CANT_READ_BASELINE:  "Не удалось прочитать базовый уровень из %s: %v",
+			GIT_ERROR:           "Не удалось выполнить \"git %s\": %s",
+			COMPARE_ARGS:        "Необходимо указать два отчёта или две ревизии git для сравнения",
+			COMPARE_MIXED:       "Нельзя сравнить отчёт с ревизией git (%s и %s)",
+			COMPARE_ARCHS:       "У отчётов нет общих архитектур (%s и %s)",
+			CANT_READ_LOCK:      "Не удалось прочитать файл блокировки %s: %v",
+			LOCK_EXISTS:         "Файл блокировки %s уже существует, используйте опцию --update для его обновления",
+			LOCK_ARCHS:          "Архитектуры %s не совпадают с архитектурами %s из файла блокировки %s, удалите файл блокировки для их изменения",
+			LOCK_REMOVED:        "Структура %s из файла блокировки %s больше не существует",
+			CANT_READ_CONFIG:    "Не удалось прочитать конфигурацию из %s: %v",
+			UNKNOWN_CONFIG_KEYS: "Конфигурация %s содержит неизвестные ключи: %s",
+			FILE_EXISTS:         "Файл %s уже существует, используйте опцию --update для его перезаписи",
+			INVALID_REGEXP:      "Некорректное регулярное выражение %q: %v",
 
 			NO_ANY_STRUCTS:    "Указанный пакет не содержит структур",
-			NO_STRUCT:         "Структура с именем %q не найдена",
+			NO_STRUCT:         "Структуры, соответствующие %q, не найдены",
 			EMPTY_STRUCT_NAME: "Вы должны указать имя структуры",
 			NO_IMPORT_PATHS:   "Не удалось найти пути импорта",
 		},
@@ -211,31 +457,77 @@ func getRU() *I18NBundle {
 			LICENSE:             "Лицензия Apache, Версия 2.0",
 
 			COMMANDS: &I18NCommands{
-				CHECK: "Проверка на наличие проблем с выравниванием",
-				VIEW:  "Отображние информации о выравнивании",
+				CHECK:    "Проверка на наличие проблем с выравниванием",
+				VIEW:     "Отображние информации о выравнивании",
+				ANNOTATE: "Добавление или обновление комментариев со смещениями полей и размерами структур",
+				COMPARE:  "Сравнение размеров структур в двух отчётах или ревизиях git",
+				LOCK:     "Сохранение размеров структур в файл блокировки",
+				INIT:     "Создание конфигурации, базового уровня и рабочего процесса CI для модуля",
+				LSP:      "Запуск языкового сервера {s-}(stdio){!}",
 			},
 
 			OPTIONS: &I18NOptions{
-				ARCH:        "Название архитектуры",
-				ARCH_VAL:    "имя",
-				STRUCT:      "Отображение информации только для указанной структуры",
-				STRUCT_VAL:  "имя",
-				TAGS:        "Тэги сборки {s-}(повторяемая опция){!}",
-				TAGS_VAL:    "тэг…",
-				PAGER:       "Использовать постраничный вывод",
-				EXCLUDE:     "Исключение пакетов содержащие указанный шаблон {s-}(повторяемая опция){!}",
-				EXCLUDE_VAL: "шаблон…",
-				NO_COLOR:    "Отключение цветного вывода",
-				HELP:        "Показать это справочное сообщение",
-				VER:         "Показать версию",
+				ARCH:          "Название архитектуры {s-}(повторяемая опция){!}",
+				ARCH_VAL:      "имя…",
+				STRUCT:        "Отображение информации только для структур с указанными именами или шаблонами {s-}(повторяемая опция){!}",
+				STRUCT_VAL:    "имя…",
+				TAGS:          "Тэги сборки {s-}(повторяемая опция){!}",
+				TAGS_VAL:      "тэг…",
+				PAGER:         "Использовать постраничный вывод",
+				EXCLUDE:       "Исключение пакетов и файлов, соответствующих glob-шаблону {s-}(повторяемая опция){!}",
+				EXCLUDE_VAL:   "шаблон…",
+				INCLUDE:       "Проверка только пакетов и файлов, соответствующих glob-шаблону {s-}(повторяемая опция){!}",
+				EXCL_STRUCT:   "Исключение структур с именами, соответствующими регулярному выражению {s-}(повторяемая опция){!}",
+				INCL_STRUCT:   "Проверка только структур с именами, соответствующими регулярному выражению {s-}(повторяемая опция){!}",
+				REGEXP_VAL:    "выражение…",
+				INCLUDE_ABI:   "Отображение советов по оптимизации для структур с фиксированной раскладкой",
+				KEEP_ORDER:    "Сохранение исходного порядка сериализуемых полей при оптимизации",
+				INCLUDE_GEN:   "Проверка структур из сгенерированных файлов",
+				NO_ABI:        "Отключение советов по оптимизации для структур с фиксированной раскладкой",
+				NO_KEEP_ORDER: "Отключение сохранения исходного порядка сериализуемых полей",
+				NO_GEN:        "Пропуск структур из сгенерированных файлов",
+				STRIP:         "Удаление аннотаций, добавленных командой annotate",
+				FORMAT:        "Формат вывода {s-}(text|json|sarif|checkstyle|junit|github|markdown|html|svg|csv|tsv|line){!}",
+				FORMAT_VAL:    "формат",
+				FIELDS:        "Вывод строки для каждого поля в форматах CSV и TSV",
+				ABSOLUTE:      "Вывод абсолютных путей к файлам в формате line",
+				INPUT:         "Чтение сохранённого отчёта в формате JSON вместо анализа исходного кода",
+				INPUT_VAL:     "файл",
+				BASELINE:      "Игнорирование известных проблем из файла базового уровня",
+				BASELINE_VAL:  "файл",
+				WRITE_BASE:    "Запись текущих проблем в файл базового уровня",
+				NEW_REV:       "Проверка только структур, изменённых после общего предка с указанной ревизией git",
+				NEW_REV_VAL:   "ревизия",
+				NEW_PATCH:     "Проверка только структур, изменённых указанным патчем",
+				NEW_PATCH_VAL: "файл",
+				THRESHOLD:     "Максимально допустимое увеличение размера структуры в байтах для команды compare",
+				THRESHOLD_VAL: "байты",
+				LOCKED:        "Проверка размеров структур по файлу блокировки",
+				LOCK_FILE:     "Путь к файлу блокировки {s-}(по умолчанию: aligo.lock){!}",
+				LOCK_FILE_VAL: "файл",
+				UPDATE:        "Обновление существующего файла блокировки или файлов, созданных командой init",
+				WORKFLOW:      "Запись рабочего процесса CI в файл вместо вывода",
+				NO_COLOR:      "Отключение цветного вывода",
+				HELP:          "Показать это справочное сообщение",
+				VER:           "Показать версию",
 			},
 
 			EXAMPLES: &I18NExamples{
-				EXAMPLE_1: "Просмотр информации о всех структурах пакета",
-				EXAMPLE_2: "Проверка текущей директории",
-				EXAMPLE_3: "Проверка текущей директории и всех дочерних",
-				EXAMPLE_4: "Проверка текущей директории и всех дочерних с использованием тэгов",
-				EXAMPLE_5: "Отображение информации о структуре PostMessageParameters",
+				EXAMPLE_1:  "Просмотр информации о всех структурах пакета",
+				EXAMPLE_2:  "Проверка текущей директории",
+				EXAMPLE_3:  "Проверка текущей директории и всех дочерних",
+				EXAMPLE_4:  "Проверка текущей директории и всех дочерних с использованием тэгов",
+				EXAMPLE_5:  "Отображение информации о структуре PostMessageParameters",
+				EXAMPLE_6:  "Добавление комментариев с информацией о раскладке ко всем структурам",
+				EXAMPLE_7:  "Проверка текущей директории и всех дочерних с выводом проблем в формате JSON",
+				EXAMPLE_8:  "Проверка отчёта, сохранённого в формате JSON",
+				EXAMPLE_9:  "Проверка текущей директории и всех дочерних с ошибкой только при новых проблемах",
+				EXAMPLE_10: "Проверка только структур, изменённых после origin/master",
+				EXAMPLE_11: "Сравнение размеров структур в v1.0.0 и HEAD с ошибкой при увеличении любой структуры более чем на 8 байт",
+				EXAMPLE_12: "Сохранение размеров структур на amd64 и arm64 в файл блокировки",
+				EXAMPLE_13: "Проверка текущей директории и всех дочерних с ошибкой при отличии размеров структур от файла блокировки",
+				EXAMPLE_14: "Создание конфигурации, базового уровня с текущими проблемами и рабочего процесса GitHub Actions",
+				EXAMPLE_15: "Отображение информации о структуре Config из пакета api и всех структурах с именами, начинающимися с Mock",
 			},
 		},
 	}
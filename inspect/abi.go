@@ -0,0 +1,254 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"go/build"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ABI_FLAG is flag for marking structs which mirror C headers
+const ABI_FLAG = "aligo:abi"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Archs contains list of architectures used for checking fixed layouts
+var Archs []string
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// gcArchs is a list of architectures supported by gc
+var gcArchs = []string{
+	"386", "amd64", "arm", "arm64", "loong64", "mips", "mipsle", "mips64",
+	"mips64le", "ppc64", "ppc64le", "riscv64", "s390x", "sparc64", "wasm",
+}
+
+// wideAlignArchs is a list of 32-bit architectures where C compilers align
+// 8-byte values to 8 bytes, while gc aligns them to 4 bytes
+var wideAlignArchs = []string{"arm", "mips", "mipsle"}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// hostSizes implements C-like layout rules on top of gc sizes
+type hostSizes struct {
+	types.Sizes
+	wideAlign bool
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetSizesArch returns architecture of gc sizes model created by
+// types.SizesFor or empty string if sizes model is unknown
+func GetSizesArch(sizes types.Sizes) string {
+	if sizes == nil {
+		return ""
+	}
+
+	for _, arch := range gcArchs {
+		if types.SizesFor("gc", arch) == sizes {
+			return arch
+		}
+	}
+
+	return ""
+}
+
+// hasFixedLayout returns true if struct embeds structs.HostLayout or
+// contains cgo types
+func hasFixedLayout(str *types.Struct) bool {
+	for i := range str.NumFields() {
+		typ := str.Field(i).Type()
+
+		if isHostLayoutType(typ) || isCgoType(typ) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isHostLayoutType returns true if given type is structs.HostLayout
+func isHostLayoutType(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == "structs" && named.Obj().Name() == "HostLayout"
+}
+
+// isCgoType returns true if given type is type from package C or array of
+// such types
+func isCgoType(typ types.Type) bool {
+	switch t := types.Unalias(typ).(type) {
+	case *types.Array:
+		return isCgoType(t.Elem())
+	case *types.Named:
+		obj := t.Obj()
+
+		if strings.HasPrefix(obj.Name(), "_Ctype_") {
+			return true
+		}
+
+		return obj.Pkg() != nil && obj.Pkg().Path() == "C"
+	}
+
+	return false
+}
+
+// checkABILayout checks struct for differences between gc and host layouts
+// on all architectures
+func checkABILayout(str *types.Struct, archs []string) []*report.Warning {
+	var result []*report.Warning
+
+	if len(archs) == 0 {
+		archs = []string{build.Default.GOARCH}
+	}
+
+	for _, arch := range archs {
+		sizes := types.SizesFor("gc", arch)
+
+		if sizes != nil {
+			result = append(result, checkArchLayout(str, arch, sizes)...)
+		}
+	}
+
+	return result
+}
+
+// checkArchLayout checks struct for differences between gc and host layouts
+// on given architecture
+func checkArchLayout(str *types.Struct, arch string, sizes types.Sizes) []*report.Warning {
+	numFields := str.NumFields()
+
+	if numFields == 0 {
+		return nil
+	}
+
+	var result []*report.Warning
+
+	host := &hostSizes{sizes, slices.Contains(wideAlignArchs, arch)}
+	vars := make([]*types.Var, numFields)
+
+	for i := range numFields {
+		vars[i] = str.Field(i)
+	}
+
+	goOffsets := sizes.Offsetsof(vars)
+	hostOffsets := host.Offsetsof(vars)
+
+	for i := range numFields {
+		if goOffsets[i] != hostOffsets[i] {
+			result = append(result, &report.Warning{
+				Type: report.WARN_ABI_OFFSET, Arch: arch, Field: vars[i].Name(),
+			})
+		}
+	}
+
+	if sizes.Sizeof(str) == host.Sizeof(str) {
+		return result
+	}
+
+	last := vars[numFields-1]
+
+	if sizes.Sizeof(last.Type()) == 0 {
+		result = append(result, &report.Warning{
+			Type: report.WARN_ABI_ZERO_TAIL, Arch: arch, Field: last.Name(),
+		})
+	} else {
+		result = append(result, &report.Warning{
+			Type: report.WARN_ABI_SIZE, Arch: arch,
+		})
+	}
+
+	return result
+}
+
+// alignTo rounds offset up to given alignment
+func alignTo(offset, align int64) int64 {
+	return (offset + align - 1) / align * align
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Alignof returns alignment of type using C rules
+func (s *hostSizes) Alignof(typ types.Type) int64 {
+	switch t := typ.Underlying().(type) {
+	case *types.Array:
+		return s.Alignof(t.Elem())
+
+	case *types.Struct:
+		align := int64(1)
+
+		for i := range t.NumFields() {
+			align = max(align, s.Alignof(t.Field(i).Type()))
+		}
+
+		return align
+
+	case *types.Basic:
+		if s.wideAlign {
+			switch t.Kind() {
+			case types.Int64, types.Uint64, types.Float64, types.Complex128:
+				return 8
+			}
+		}
+	}
+
+	return s.Sizes.Alignof(typ)
+}
+
+// Offsetsof returns offsets of fields using C rules
+func (s *hostSizes) Offsetsof(fields []*types.Var) []int64 {
+	var offset int64
+
+	result := make([]int64, len(fields))
+
+	for i, f := range fields {
+		offset = alignTo(offset, s.Alignof(f.Type()))
+		result[i] = offset
+		offset += s.Sizeof(f.Type())
+	}
+
+	return result
+}
+
+// Sizeof returns size of type using C rules
+func (s *hostSizes) Sizeof(typ types.Type) int64 {
+	switch t := typ.Underlying().(type) {
+	case *types.Array:
+		return t.Len() * s.Sizeof(t.Elem())
+
+	case *types.Struct:
+		numFields := t.NumFields()
+
+		if numFields == 0 {
+			return 0
+		}
+
+		vars := make([]*types.Var, numFields)
+
+		for i := range numFields {
+			vars[i] = t.Field(i)
+		}
+
+		offsets := s.Offsetsof(vars)
+		size := offsets[numFields-1] + s.Sizeof(vars[numFields-1].Type())
+
+		return alignTo(size, s.Alignof(t))
+	}
+
+	return s.Sizes.Sizeof(typ)
+}
@@ -0,0 +1,203 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"go/token"
+	"go/types"
+	"slices"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var (
+	testCPkg     = types.NewPackage("C", "C")
+	testMainPkg  = types.NewPackage("example.com/test", "test")
+	testCChar    = newTestNamed(testCPkg, "char", types.Typ[types.Int8])
+	testCInt     = newTestNamed(testCPkg, "int", types.Typ[types.Int32])
+	testCLong    = newTestNamed(testCPkg, "longlong", types.Typ[types.Int64])
+	testCDouble  = newTestNamed(testMainPkg, "_Ctype_double", types.Typ[types.Float64])
+	testGoInt64  = newTestNamed(testMainPkg, "Counter", types.Typ[types.Int64])
+	testHostType = newTestNamed(types.NewPackage("structs", "structs"), "HostLayout", types.NewStruct(nil, nil))
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestIsCgoType(t *testing.T) {
+	tests := []struct {
+		name  string
+		typ   types.Type
+		cgo   bool
+		fixed bool
+	}{
+		{"C type", testCChar, true, true},
+		{"cgo prefixed type", testCDouble, true, true},
+		{"array of C types", types.NewArray(testCLong, 4), true, true},
+		{"nested array of C types", types.NewArray(types.NewArray(testCInt, 2), 2), true, true},
+		{"pointer to C type", types.NewPointer(testCChar), false, false},
+		{"slice of C types", types.NewSlice(testCChar), false, false},
+		{"Go type", testGoInt64, false, false},
+		{"basic type", types.Typ[types.Int64], false, false},
+		{"host layout", testHostType, false, true},
+	}
+
+	for _, tt := range tests {
+		if got := isCgoType(tt.typ); got != tt.cgo {
+			t.Errorf("%s: isCgoType = %t, want %t", tt.name, got, tt.cgo)
+		}
+
+		if got := hasFixedLayout(newTestStruct(tt.typ)); got != tt.fixed {
+			t.Errorf("%s: hasFixedLayout = %t, want %t", tt.name, got, tt.fixed)
+		}
+	}
+}
+
+func TestCheckArchLayout(t *testing.T) {
+	tests := []struct {
+		name string
+		str  *types.Struct
+		want map[string][]string
+	}{
+		{
+			"padded long long",
+			newTestStruct(testCChar, testCLong),
+			map[string][]string{"arm": {"abi-offset:B", "abi-size:"}},
+		},
+		{
+			"trailing long long",
+			newTestStruct(testCLong, testCChar),
+			map[string][]string{"arm": {"abi-size:"}},
+		},
+		{
+			"padded double",
+			newTestStruct(testCInt, testCDouble, testCChar),
+			map[string][]string{"arm": {"abi-offset:B", "abi-offset:C", "abi-size:"}},
+		},
+		{
+			"nested struct",
+			newTestStruct(testCChar, newTestStruct(testCLong)),
+			map[string][]string{"arm": {"abi-offset:B", "abi-size:"}},
+		},
+		{
+			"array of long long",
+			newTestStruct(testCInt, types.NewArray(testCLong, 2)),
+			map[string][]string{"arm": {"abi-offset:B", "abi-size:"}},
+		},
+		{
+			"zero-size tail",
+			newTestStruct(testCInt, types.NewArray(testCChar, 0)),
+			map[string][]string{
+				"amd64": {"abi-zero-tail:B"},
+				"386":   {"abi-zero-tail:B"},
+				"arm":   {"abi-zero-tail:B"},
+			},
+		},
+		{
+			"same layout",
+			newTestStruct(testCInt, testCInt, testCChar),
+			nil,
+		},
+		{
+			"empty struct",
+			newTestStruct(),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, arch := range []string{"amd64", "386", "arm"} {
+			var got []string
+
+			for _, w := range checkArchLayout(tt.str, arch, types.SizesFor("gc", arch)) {
+				if w.Arch != arch {
+					t.Errorf("%s/%s: got warning for arch %s", tt.name, arch, w.Arch)
+				}
+
+				got = append(got, w.Type+":"+w.Field)
+			}
+
+			if !slices.Equal(got, tt.want[arch]) {
+				t.Errorf("%s/%s: got warnings %v, want %v", tt.name, arch, got, tt.want[arch])
+			}
+		}
+	}
+}
+
+func TestHostSizes(t *testing.T) {
+	tests := []struct {
+		arch   string
+		size   int64
+		align  int64
+		offset int64
+	}{
+		{"amd64", 16, 8, 8},
+		{"386", 12, 4, 4},
+		{"arm", 16, 8, 8},
+		{"mipsle", 16, 8, 8},
+		{"arm64", 16, 8, 8},
+	}
+
+	str := newTestStruct(testCChar, testCLong)
+
+	for _, tt := range tests {
+		sizes := &hostSizes{types.SizesFor("gc", tt.arch), slices.Contains(wideAlignArchs, tt.arch)}
+		offsets := sizes.Offsetsof([]*types.Var{str.Field(0), str.Field(1)})
+
+		if got := sizes.Sizeof(str); got != tt.size {
+			t.Errorf("%s: got size %d, want %d", tt.arch, got, tt.size)
+		}
+
+		if got := sizes.Alignof(str); got != tt.align {
+			t.Errorf("%s: got align %d, want %d", tt.arch, got, tt.align)
+		}
+
+		if offsets[1] != tt.offset {
+			t.Errorf("%s: got offset %d, want %d", tt.arch, offsets[1], tt.offset)
+		}
+	}
+}
+
+func TestGetSizesArch(t *testing.T) {
+	tests := []struct {
+		sizes types.Sizes
+		want  string
+	}{
+		{types.SizesFor("gc", "amd64"), "amd64"},
+		{types.SizesFor("gc", "386"), "386"},
+		{types.SizesFor("gc", "arm"), "arm"},
+		{types.SizesFor("gc", "mipsle"), "mipsle"},
+		{types.SizesFor("gccgo", "amd64"), ""},
+		{&types.StdSizes{WordSize: 4, MaxAlign: 4}, ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		if got := GetSizesArch(tt.sizes); got != tt.want {
+			t.Errorf("GetSizesArch(%v) = %q, want %q", tt.sizes, got, tt.want)
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// newTestNamed creates named type in given package
+func newTestNamed(pkg *types.Package, name string, typ types.Type) *types.Named {
+	return types.NewNamed(types.NewTypeName(token.NoPos, pkg, name, nil), typ, nil)
+}
+
+// newTestStruct creates struct with fields A, B, C… of given types
+func newTestStruct(fieldTypes ...types.Type) *types.Struct {
+	var fields []*types.Var
+
+	for i, typ := range fieldTypes {
+		fields = append(fields, types.NewField(token.NoPos, testMainPkg, string(rune('A'+i)), typ, false))
+	}
+
+	return types.NewStruct(fields, nil)
+}
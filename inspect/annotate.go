@@ -0,0 +1,336 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// fieldAnnotationRegex is regex for managed field annotation (at the end of comment)
+var fieldAnnotationRegex = regexp.MustCompile(
+	`\s*// \+\d+ \(\d+B\)(?: pad \d+B)?(?:, \+\d+ \(\d+B\)(?: pad \d+B)?)*$`,
+)
+
+// structAnnotationRegex is regex for managed struct annotation
+var structAnnotationRegex = regexp.MustCompile(`^// size: \d+(?:, optimal: \d+)?$`)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// textEdit contains info about replacement of part of source
+type textEdit struct {
+	Text  string
+	Start int
+	End   int
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Annotate writes or refreshes managed comments with fields offsets and struct
+// sizes in source files. If strip is true, managed comments will be removed.
+// Ignored structs are not annotated and their managed comments are removed.
+// Returns list of modified files.
+func Annotate(r *report.Report, strip bool) ([]string, error) {
+	var files []string
+
+	structs := map[string][]*report.Struct{}
+
+	for _, pkg := range r.Packages {
+		for _, str := range pkg.Structs {
+			file := str.Position.Path
+
+			if structs[file] == nil {
+				files = append(files, file)
+			}
+
+			structs[file] = append(structs[file], str)
+		}
+	}
+
+	var result []string
+
+	for _, file := range files {
+		changed, err := annotateFile(file, structs[file], strip)
+
+		if err != nil {
+			return result, err
+		}
+
+		if changed {
+			result = append(result, file)
+		}
+	}
+
+	return result, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// annotateFile updates managed comments in given file
+func annotateFile(file string, structs []*report.Struct, strip bool) (bool, error) {
+	src, err := os.ReadFile(file)
+
+	if err != nil {
+		return false, err
+	}
+
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, file, src, parser.ParseComments)
+
+	if err != nil {
+		return false, err
+	}
+
+	var declEdits []textEdit
+
+	nodes := FindStructNodes(fset, []*ast.File{astFile})
+
+	for _, str := range structs {
+		node := nodes[str.Position]
+
+		if node == nil {
+			continue
+		}
+
+		edits := getStructAnnotationEdits(fset, src, node, str, strip || str.Ignore)
+
+		if len(edits) == 0 {
+			continue
+		}
+
+		edit, err := getDeclEdit(fset, src, node.Decl, edits)
+
+		if err != nil {
+			return false, err
+		}
+
+		declEdits = append(declEdits, edit)
+	}
+
+	if len(declEdits) == 0 {
+		return false, nil
+	}
+
+	data := applyEdits(src, declEdits, 0)
+
+	if bytes.Equal(src, data) {
+		return false, nil
+	}
+
+	info, err := os.Stat(file)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, os.WriteFile(file, data, info.Mode())
+}
+
+// getDeclEdit applies edits to declaration and returns edit with formatted
+// declaration, so code outside of declaration is kept as is
+func getDeclEdit(fset *token.FileSet, src []byte, decl *ast.GenDecl, edits []textEdit) (textEdit, error) {
+	start := fset.Position(decl.Pos()).Offset
+
+	if decl.Doc != nil {
+		start = fset.Position(decl.Doc.Pos()).Offset
+	}
+
+	start = getLineStart(src, start)
+	end := fset.Position(decl.End()).Offset
+
+	data, err := format.Source(applyEdits(src[start:end], edits, start))
+
+	return textEdit{Start: start, End: end, Text: string(data)}, err
+}
+
+// applyEdits applies edits to source which starts at given offset
+func applyEdits(src []byte, edits []textEdit, offset int) []byte {
+	edits = slices.Clone(edits)
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].Start > edits[j].Start })
+
+	data := bytes.Clone(src)
+
+	for _, e := range edits {
+		start, end := e.Start-offset, e.End-offset
+		data = append(data[:start:start], append([]byte(e.Text), data[end:]...)...)
+	}
+
+	return data
+}
+
+// getStructAnnotationEdits returns edits for struct and its fields
+func getStructAnnotationEdits(fset *token.FileSet, src []byte, node *StructNode, str *report.Struct, strip bool) []textEdit {
+	var result []textEdit
+
+	text := ""
+
+	if !strip {
+		text = formatStructAnnotation(str)
+	}
+
+	if edit := getDocEdit(fset, src, node.Decl, text); edit != nil {
+		result = append(result, *edit)
+	}
+
+	refs := FlattenFields(node.Type.Fields.List)
+
+	if len(refs) != len(str.Fields) {
+		return result
+	}
+
+	var fieldIndex int
+
+	fields := node.Type.Fields
+
+	for i, field := range fields.List {
+		var annotations []string
+
+		for range max(len(field.Names), 1) {
+			annotations = append(annotations, formatFieldAnnotation(str.Fields[fieldIndex]))
+			fieldIndex++
+		}
+
+		// Line comment would hide the next field or closing brace
+		line := fset.Position(field.End()).Line
+
+		if line == fset.Position(fields.Closing).Line ||
+			(i+1 < len(fields.List) && line == fset.Position(fields.List[i+1].Pos()).Line) {
+			continue
+		}
+
+		text := ""
+
+		if !strip {
+			text = "// " + strings.Join(annotations, ", ")
+		}
+
+		if edit := getFieldCommentEdit(fset, src, field, text); edit != nil {
+			result = append(result, *edit)
+		}
+	}
+
+	return result
+}
+
+// getDocEdit returns edit for managed line in declaration doc
+func getDocEdit(fset *token.FileSet, src []byte, decl *ast.GenDecl, text string) *textEdit {
+	if decl.Doc != nil {
+		for _, c := range decl.Doc.List {
+			if !structAnnotationRegex.MatchString(c.Text) {
+				continue
+			}
+
+			start := fset.Position(c.Pos()).Offset
+			end := fset.Position(c.End()).Offset
+
+			if text != "" {
+				return &textEdit{Start: start, End: end, Text: text}
+			}
+
+			// Remove whole line with comment
+			return &textEdit{Start: getLineStart(src, start), End: min(end+1, len(src))}
+		}
+	}
+
+	if text == "" {
+		return nil
+	}
+
+	pos := fset.Position(decl.Pos()).Offset
+	lineStart := getLineStart(src, pos)
+	indent := string(src[lineStart:pos])
+
+	return &textEdit{Start: lineStart, End: lineStart, Text: indent + text + "\n"}
+}
+
+// getFieldCommentEdit returns edit for managed part of field trailing comment
+func getFieldCommentEdit(fset *token.FileSet, src []byte, field *ast.Field, text string) *textEdit {
+	fieldEnd := fset.Position(field.End()).Offset
+
+	if field.Comment == nil {
+		if text == "" {
+			return nil
+		}
+
+		return &textEdit{Start: fieldEnd, End: fieldEnd, Text: " " + text}
+	}
+
+	c := field.Comment.List[len(field.Comment.List)-1]
+	start := fset.Position(c.Pos()).Offset
+	end := fset.Position(c.End()).Offset
+
+	if !strings.HasPrefix(c.Text, "//") {
+		if text == "" {
+			return nil
+		}
+
+		return &textEdit{Start: end, End: end, Text: " " + text}
+	}
+
+	userComment := fieldAnnotationRegex.ReplaceAllString(c.Text, "")
+
+	switch {
+	case userComment != "" && text != "":
+		text = userComment + " " + text
+	case userComment != "":
+		text = userComment
+	case text == "":
+		// Remove comment with leading whitespace
+		for start > fieldEnd && (src[start-1] == ' ' || src[start-1] == '\t') {
+			start--
+		}
+	}
+
+	return &textEdit{Start: start, End: end, Text: text}
+}
+
+// formatStructAnnotation formats managed struct annotation. Optimal size is
+// omitted for structs with fixed layout because they can't be reordered.
+func formatStructAnnotation(str *report.Struct) string {
+	if str.Size != str.OptimalSize && !str.ABI {
+		return fmt.Sprintf("// size: %d, optimal: %d", str.Size, str.OptimalSize)
+	}
+
+	return fmt.Sprintf("// size: %d", str.Size)
+}
+
+// formatFieldAnnotation formats managed field annotation
+func formatFieldAnnotation(f *report.Field) string {
+	if f.Padding > 0 {
+		return fmt.Sprintf("+%d (%dB) pad %dB", f.Offset, f.Size, f.Padding)
+	}
+
+	return fmt.Sprintf("+%d (%dB)", f.Offset, f.Size)
+}
+
+// stripFieldAnnotation removes managed annotation from field comment
+func stripFieldAnnotation(comment string) string {
+	comment = strings.TrimRight(comment, "\n\r")
+	comment = fieldAnnotationRegex.ReplaceAllString("// "+comment, "")
+
+	return strings.TrimSpace(strings.TrimPrefix(comment, "//"))
+}
+
+// getLineStart returns offset of the start of line with given offset
+func getLineStart(src []byte, offset int) int {
+	return bytes.LastIndexByte(src[:offset], '\n') + 1
+}
@@ -0,0 +1,173 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const testAnnotateSource = `package test
+
+import "structs"
+
+// Data contains data
+type Data struct {
+	Flag  bool   // user comment
+	Count int64  /* block comment */
+	Name  string //nolint:all
+	A, B  bool
+}
+
+// Ignored is ignored
+// aligo:ignore
+type Ignored struct {
+	Flag  bool
+	Count int64
+}
+
+type Short struct{ X, Y int64 }
+
+// Host has fixed layout
+type Host struct {
+	_     structs.HostLayout
+	Flag  bool
+	Count int64
+	Ready bool
+}
+
+var  unformatted = map[string]int{"a":1}
+`
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestAnnotationRegexps(t *testing.T) {
+	tests := []struct {
+		text  string
+		field bool
+		str   bool
+	}{
+		{"// +0 (8B)", true, false},
+		{"// +0 (1B) pad 7B", true, false},
+		{"// +0 (1B), +1 (1B) pad 6B", true, false},
+		{"// user comment // +8 (8B)", true, false},
+		{"// +8 bytes", false, false},
+		{"// size: 24", false, true},
+		{"// size: 24, optimal: 16", false, true},
+		{"// size: 24 bytes", false, false},
+		{"// Data size: 24", false, false},
+	}
+
+	for _, tt := range tests {
+		if got := fieldAnnotationRegex.MatchString(tt.text); got != tt.field {
+			t.Errorf("field regex for %q: got %t, want %t", tt.text, got, tt.field)
+		}
+
+		if got := structAnnotationRegex.MatchString(tt.text); got != tt.str {
+			t.Errorf("struct regex for %q: got %t, want %t", tt.text, got, tt.str)
+		}
+	}
+}
+
+func TestStripFieldAnnotation(t *testing.T) {
+	tests := []struct {
+		comment string
+		want    string
+	}{
+		{"+0 (8B)\n", ""},
+		{"user comment // +0 (1B) pad 7B\n", "user comment"},
+		{"+0 (1B), +1 (1B) pad 6B", ""},
+		{"user comment", "user comment"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := stripFieldAnnotation(tt.comment); got != tt.want {
+			t.Errorf("stripFieldAnnotation(%q) = %q, want %q", tt.comment, got, tt.want)
+		}
+	}
+}
+
+func TestAnnotateRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.go")
+
+	err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/test\n\ngo 1.23\n"), 0644)
+
+	if err == nil {
+		err = os.WriteFile(file, []byte(testAnnotateSource), 0644)
+	}
+
+	if err != nil {
+		t.Fatalf("can't create module: %v", err)
+	}
+
+	opts := &Options{Sizes: types.SizesFor("gc", "amd64")}
+
+	tests := []struct {
+		name     string
+		strip    bool
+		contains []string
+		missing  []string
+	}{
+		{
+			"annotate", false,
+			[]string{
+				"// Data contains data\n// size: 40, optimal: 32\n",
+				"// user comment // +0 (1B) pad 7B",
+				"/* block comment */ // +8 (8B)",
+				"//nolint:all // +16 (16B)",
+				"// +32 (1B), +33 (1B) pad 6B",
+				"// size: 16\ntype Short struct{ X, Y int64 }",
+				"// Host has fixed layout\n// size: 24\n",
+				"var  unformatted = map[string]int{\"a\":1}",
+			},
+			[]string{"// size: 16\ntype Ignored"},
+		},
+		{"annotate again", false, []string{"// size: 40, optimal: 32\n"}, nil},
+		{"strip", true, nil, []string{"// size:", "(8B)"}},
+	}
+
+	for _, tt := range tests {
+		r, err := ProcessFile(file, nil, opts)
+
+		if err != nil {
+			t.Fatalf("%s: can't process file: %v", tt.name, err)
+		}
+
+		_, err = Annotate(r, tt.strip)
+
+		if err != nil {
+			t.Fatalf("%s: can't annotate file: %v", tt.name, err)
+		}
+
+		data, _ := os.ReadFile(file)
+		src := string(data)
+
+		for _, text := range tt.contains {
+			if !strings.Contains(src, text) {
+				t.Errorf("%s: source doesn't contain %q:\n%s", tt.name, text, src)
+			}
+		}
+
+		for _, text := range tt.missing {
+			if strings.Contains(src, text) {
+				t.Errorf("%s: source contains %q:\n%s", tt.name, text, src)
+			}
+		}
+
+		if tt.strip && src != testAnnotateSource {
+			t.Errorf("%s: source differs from original:\n%s", tt.name, src)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"go/ast"
+	"go/build"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// MAXSIZE_FLAG is flag for defining struct size budget
+const MAXSIZE_FLAG = "aligo:maxsize"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// maxSizeRegex is regex for size budget directive (aligo:maxsize 64 arch=amd64,arm64)
+var maxSizeRegex = regexp.MustCompile(`aligo:maxsize\s+(\d+)(?:\s+arch=(\S+))?`)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getSizeBudgets returns size budgets defined in struct comments and list of
+// unknown architectures used in directives. Budget with empty arch is applied
+// to all architectures.
+func getSizeBudgets(cm ast.CommentMap) (map[string]int64, []string) {
+	if cm == nil || len(cm.Comments()) == 0 {
+		return nil, nil
+	}
+
+	var result map[string]int64
+	var unknown []string
+
+	for _, cg := range cm.Comments() {
+		for _, c := range cg.List {
+			for _, m := range maxSizeRegex.FindAllStringSubmatch(strings.ToLower(c.Text), -1) {
+				size, err := strconv.ParseInt(m[1], 10, 64)
+
+				if err != nil {
+					continue
+				}
+
+				if result == nil {
+					result = map[string]int64{}
+				}
+
+				if m[2] == "" {
+					result[""] = size
+					continue
+				}
+
+				for _, arch := range strings.Split(m[2], ",") {
+					switch {
+					case arch == "":
+						continue
+					case types.SizesFor("gc", arch) == nil:
+						unknown = append(unknown, arch)
+					default:
+						result[arch] = size
+					}
+				}
+			}
+		}
+	}
+
+	return result, unknown
+}
+
+// getUnknownArchWarnings returns warnings about unknown architectures used in
+// size budget directives
+func getUnknownArchWarnings(archs []string) []*report.Warning {
+	var result []*report.Warning
+
+	for _, arch := range archs {
+		result = append(result, &report.Warning{Type: report.WARN_BUDGET_ARCH, Arch: arch})
+	}
+
+	return result
+}
+
+// checkSizeBudgets checks struct size against budgets on all architectures
+func checkSizeBudgets(str *types.Struct, budgets map[string]int64, archs []string) []*report.Warning {
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	var result []*report.Warning
+
+	if len(archs) == 0 {
+		archs = []string{build.Default.GOARCH}
+	}
+
+	for _, arch := range archs {
+		budget, ok := budgets[arch]
+
+		if !ok {
+			budget, ok = budgets[""]
+		}
+
+		sizes := types.SizesFor("gc", arch)
+
+		if !ok || sizes == nil {
+			continue
+		}
+
+		size := sizes.Sizeof(str)
+
+		if size <= budget {
+			continue
+		}
+
+		result = append(result, &report.Warning{
+			Type:    report.WARN_MAX_SIZE,
+			Arch:    arch,
+			Size:    size,
+			MaxSize: budget,
+			Fields:  getFieldsOverBudget(str, sizes, budget),
+		})
+	}
+
+	return result
+}
+
+// getArchSizes returns struct size on every given arch
+func getArchSizes(str *types.Struct, archs []string) map[string]int64 {
+	if len(archs) == 0 {
+		return nil
+	}
+
+	result := map[string]int64{}
+
+	for _, arch := range archs {
+		sizes := types.SizesFor("gc", arch)
+
+		if sizes != nil {
+			result[arch] = sizes.Sizeof(str)
+		}
+	}
+
+	return result
+}
+
+// getFieldsOverBudget returns names of fields which end after budget
+func getFieldsOverBudget(str *types.Struct, sizes types.Sizes, budget int64) []string {
+	var result []string
+	var last string
+
+	vars := make([]*types.Var, str.NumFields())
+
+	for i := range vars {
+		vars[i] = str.Field(i)
+	}
+
+	offsets := sizes.Offsetsof(vars)
+
+	for i, v := range vars {
+		size := sizes.Sizeof(v.Type())
+
+		if size != 0 {
+			last = v.Name()
+		}
+
+		if size != 0 && offsets[i]+size > budget {
+			result = append(result, v.Name())
+		}
+	}
+
+	// Struct exceeds budget because of trailing padding
+	if len(result) == 0 && last != "" {
+		result = append(result, last)
+	}
+
+	return result
+}
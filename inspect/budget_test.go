@@ -0,0 +1,102 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestGetSizeBudgets(t *testing.T) {
+	tests := []struct {
+		doc     string
+		budgets map[string]int64
+		unknown []string
+	}{
+		{"// aligo:maxsize 64", map[string]int64{"": 64}, nil},
+		{"// aligo:maxsize   32", map[string]int64{"": 32}, nil},
+		{"// Aligo:MaxSize 16 Arch=AMD64", map[string]int64{"amd64": 16}, nil},
+		{"// aligo:maxsize 64 arch=amd64,arm64", map[string]int64{"amd64": 64, "arm64": 64}, nil},
+		{"// aligo:maxsize 64\n// aligo:maxsize 32 arch=386", map[string]int64{"": 64, "386": 32}, nil},
+		{"// aligo:maxsize 64 arch=amd64,,z80", map[string]int64{"amd64": 64}, []string{"z80"}},
+		{"// aligo:maxsize 64 arch=z80", map[string]int64{}, []string{"z80"}},
+		{"// aligo:maxsize", nil, nil},
+		{"// aligo:maxsize big", nil, nil},
+		{"// Data contains data", nil, nil},
+	}
+
+	for _, tt := range tests {
+		budgets, unknown := getSizeBudgets(getTestCommentMap(t, tt.doc))
+
+		if !maps.Equal(budgets, tt.budgets) || (budgets == nil) != (tt.budgets == nil) {
+			t.Errorf("%q: got budgets %v, want %v", tt.doc, budgets, tt.budgets)
+		}
+
+		if !slices.Equal(unknown, tt.unknown) {
+			t.Errorf("%q: got unknown archs %v, want %v", tt.doc, unknown, tt.unknown)
+		}
+	}
+}
+
+func TestCheckSizeBudgets(t *testing.T) {
+	str := types.NewStruct([]*types.Var{
+		types.NewField(token.NoPos, nil, "A", types.Typ[types.Bool], false),
+		types.NewField(token.NoPos, nil, "B", types.Typ[types.Int], false),
+		types.NewField(token.NoPos, nil, "C", types.Typ[types.Bool], false),
+	}, nil)
+
+	tests := []struct {
+		name    string
+		budgets map[string]int64
+		archs   []string
+		want    []string
+	}{
+		{"no budgets", nil, []string{"amd64"}, nil},
+		{"fits", map[string]int64{"": 24}, []string{"amd64", "386"}, nil},
+		{"all archs", map[string]int64{"": 8}, []string{"amd64", "386"}, []string{"amd64:24:B,C", "386:12:C"}},
+		{"arch override", map[string]int64{"": 8, "amd64": 24}, []string{"amd64", "386"}, []string{"386:12:C"}},
+		{"arch only", map[string]int64{"amd64": 16}, []string{"amd64", "386"}, []string{"amd64:24:C"}},
+		{"trailing padding", map[string]int64{"amd64": 20}, []string{"amd64"}, []string{"amd64:24:C"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+
+		for _, w := range checkSizeBudgets(str, tt.budgets, tt.archs) {
+			got = append(got, fmt.Sprintf("%s:%d:%s", w.Arch, w.Size, strings.Join(w.Fields, ",")))
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getTestCommentMap returns comment map for struct with given doc comment
+func getTestCommentMap(t *testing.T, doc string) ast.CommentMap {
+	fset := token.NewFileSet()
+	src := "package test\n\n" + doc + "\ntype Data struct {\n\tA int64\n}\n"
+	file, err := parser.ParseFile(fset, "test.go", src, parser.ParseComments)
+
+	if err != nil {
+		t.Fatalf("can't parse source: %v", err)
+	}
+
+	return ast.NewCommentMap(fset, file, file.Comments).Filter(file.Decls[0])
+}
@@ -0,0 +1,152 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Filter contains filters for packages, files and structs
+type Filter struct {
+	// Include and Exclude contain glob patterns for import paths and files.
+	// Files are matched as import path of package with file name
+	// (e.g. github.com/user/project/mocks/client.go). Patterns without glob
+	// meta characters are matched as substrings.
+	Include []string
+	Exclude []string
+
+	// IncludeStructs and ExcludeStructs contain regular expressions for
+	// struct names
+	IncludeStructs []*regexp.Regexp
+	ExcludeStructs []*regexp.Regexp
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsEmpty returns true if filter doesn't contain any patterns
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (len(f.Include) == 0 && len(f.Exclude) == 0 &&
+		len(f.IncludeStructs) == 0 && len(f.ExcludeStructs) == 0)
+}
+
+// IsExcludedPackage returns true if package with given import path is excluded
+func (f *Filter) IsExcludedPackage(pkgPath string) bool {
+	return f != nil && matchAnyGlob(f.Exclude, pkgPath)
+}
+
+// IsAllowedStruct returns true if struct from given package passes filter
+func (f *Filter) IsAllowedStruct(pkgPath string, str *report.Struct) bool {
+	if f == nil {
+		return true
+	}
+
+	file := pkgPath + "/" + str.Position.File
+
+	if matchAnyGlob(f.Exclude, pkgPath) || matchAnyGlob(f.Exclude, file) ||
+		matchAnyRegexp(f.ExcludeStructs, str.Name) {
+		return false
+	}
+
+	if len(f.Include) != 0 && !matchAnyGlob(f.Include, pkgPath) && !matchAnyGlob(f.Include, file) {
+		return false
+	}
+
+	if len(f.IncludeStructs) != 0 && !matchAnyRegexp(f.IncludeStructs, str.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Apply removes structs which don't pass filter from report
+func (f *Filter) Apply(r *report.Report) {
+	if f.IsEmpty() || r == nil {
+		return
+	}
+
+	r.Packages = slices.DeleteFunc(r.Packages, func(pkg *report.Package) bool {
+		if len(pkg.Structs) == 0 {
+			return false
+		}
+
+		pkg.Structs = slices.DeleteFunc(pkg.Structs, func(str *report.Struct) bool {
+			return !f.IsAllowedStruct(pkg.Path, str)
+		})
+
+		return len(pkg.Structs) == 0 && pkg.Generated == 0
+	})
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// MatchGlob returns true if given slash-separated name or any of its trailing
+// parts matches glob pattern. Pattern "**" matches any number of path segments.
+func MatchGlob(pattern, name string) bool {
+	patternParts := strings.Split(strings.Trim(pattern, "/"), "/")
+	nameParts := strings.Split(strings.Trim(name, "/"), "/")
+
+	for i := range nameParts {
+		if matchGlobParts(patternParts, nameParts[i:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// matchGlobParts matches path segments against pattern segments
+func matchGlobParts(pattern, name []string) bool {
+	for len(pattern) != 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchGlobParts(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		ok, _ := path.Match(pattern[0], name[0])
+
+		if !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+// matchAnyGlob returns true if name matches any of given glob patterns
+func matchAnyGlob(patterns []string, name string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		return MatchGlob(pattern, name)
+	})
+}
+
+// matchAnyRegexp returns true if name matches any of given regular expressions
+func matchAnyRegexp(patterns []*regexp.Regexp, name string) bool {
+	return slices.ContainsFunc(patterns, func(re *regexp.Regexp) bool {
+		return re.MatchString(name)
+	})
+}
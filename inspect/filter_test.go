@@ -0,0 +1,85 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"github.com/user/project", "github.com/user/project", true},
+		{"project", "github.com/user/project", true},
+		{"user", "github.com/user/project", false},
+		{"*_gen.go", "github.com/user/project/api_gen.go", true},
+		{"*_gen.go", "github.com/user/project/api.go", false},
+		{"*/mocks", "github.com/user/project/mocks", true},
+		{"*/mocks", "github.com/user/project/mocks/client.go", false},
+		{"**/mocks/**", "github.com/user/project/mocks/client.go", true},
+		{"**/mocks/**", "github.com/user/project/mocks", true},
+		{"**/mocks/**", "github.com/user/project/mocksutil", false},
+		{"github.com/**/api", "github.com/user/project/internal/api", true},
+		{"github.com/*/api", "github.com/user/project/api", false},
+		{"github.com/*/*/api", "github.com/user/project/api", true},
+		{"project/*/api", "github.com/user/project/internal/v1/api", false},
+		{"api?.go", "github.com/user/project/api1.go", true},
+		{"[ab].go", "github.com/user/project/c.go", false},
+		{"/project/", "github.com/user/project", true},
+		{"mock", "github.com/user/mockingbird", false},
+		{"mock", "github.com/user/mock/client.go", false},
+		{"mock/**", "github.com/user/mock/client.go", true},
+	}
+
+	for _, tt := range tests {
+		if got := MatchGlob(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("MatchGlob(%q, %q) = %t, want %t", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsAllowedStruct(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *Filter
+		pkg    string
+		str    string
+		file   string
+		want   bool
+	}{
+		{"nil filter", nil, "example.com/a", "A", "a.go", true},
+		{"plain exclude", &Filter{Exclude: []string{"mocks"}}, "example.com/a/mocks", "A", "a.go", false},
+		{"plain exclude in file", &Filter{Exclude: []string{"a_gen.go"}}, "example.com/a", "A", "a_gen.go", false},
+		{"plain exclude prefix", &Filter{Exclude: []string{"mock"}}, "example.com/mockingbird", "A", "a.go", true},
+		{"plain exclude substring", &Filter{Exclude: []string{"_gen"}}, "example.com/a", "A", "a_gen.go", true},
+		{"glob exclude", &Filter{Exclude: []string{"*_gen.go"}}, "example.com/a", "A", "a_gen.go", false},
+		{"glob exclude mismatch", &Filter{Exclude: []string{"*/mock"}}, "example.com/a/mocks", "A", "a.go", true},
+		{"include", &Filter{Include: []string{"**/api/**"}}, "example.com/api/v1", "A", "a.go", true},
+		{"include mismatch", &Filter{Include: []string{"**/api/**"}}, "example.com/web", "A", "a.go", false},
+		{"exclude structs", &Filter{ExcludeStructs: []*regexp.Regexp{regexp.MustCompile("^Mock")}}, "example.com/a", "MockA", "a.go", false},
+		{"include structs", &Filter{IncludeStructs: []*regexp.Regexp{regexp.MustCompile("^Mock")}}, "example.com/a", "A", "a.go", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			str := &report.Struct{Name: tt.str, Position: report.Position{File: tt.file}}
+
+			if got := tt.filter.IsAllowedStruct(tt.pkg, str); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
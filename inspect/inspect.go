@@ -12,6 +12,7 @@ import (
 	"go/token"
 	"go/types"
 	"path"
+	"path/filepath"
 	"reflect"
 	"slices"
 	"sort"
@@ -36,64 +37,118 @@ const IGNORE_FLAG = "aligo:ignore"
 // Sizes contains info about WordSize and MaxAlign
 var Sizes types.Sizes
 
+// IncludeGenerated enables checking of structs from generated files
+var IncludeGenerated bool
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Options contains options for sources processing
+type Options struct {
+	Sizes            types.Sizes // sizes model used for calculating layout
+	Dir              string      // directory for resolving relative patterns
+	Archs            []string    // architectures for checking fixed layouts and size budgets
+	KeepTaggedOrder  bool        // keep original relative order of serialized fields
+	IncludeGenerated bool        // check structs from generated files
+}
+
+// StructNode contains AST nodes of struct declaration
+type StructNode struct {
+	Decl *ast.GenDecl
+	Type *ast.StructType
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 type structInfo struct {
+	Fset     *token.FileSet
+	Options  *Options
 	Name     string
 	Type     *types.Struct
 	AST      *ast.StructType
 	Pos      token.Position
 	Mappings map[string]string
+	Comments []*ast.CommentGroup // all comments from file with struct
+	Formats  []string
+	Budgets  map[string]int64
+	Unknown  []string // unknown architectures from size budget directives
 	Skip     bool
+	ABI      bool
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-var fileSet *token.FileSet
-
-// ////////////////////////////////////////////////////////////////////////////////// //
+// DefaultOptions returns processing options configured with package variables
+func DefaultOptions() *Options {
+	return &Options{
+		Sizes:            Sizes,
+		Archs:            Archs,
+		KeepTaggedOrder:  KeepTaggedOrder,
+		IncludeGenerated: IncludeGenerated,
+	}
+}
 
 // ProcessSources starts sources processing
-func ProcessSources(dirs, tags, excludes []string) (*report.Report, error) {
-	importPaths := sliceutil.Filter(gotool.ImportPaths(dirs), func(importPath string, _ int) bool {
-		return !slices.ContainsFunc(excludes, func(exclude string) bool {
-			return strings.Contains(importPath, exclude)
+func ProcessSources(dirs, tags []string, filter *Filter, opts *Options) (*report.Report, error) {
+	importPaths := dirs
+
+	// gotool expands local patterns relative to current directory, so patterns
+	// for other directory are passed to go list as is
+	if opts.Dir == "" {
+		importPaths = sliceutil.Filter(gotool.ImportPaths(dirs), func(importPath string, _ int) bool {
+			return !filter.IsExcludedPackage(importPath)
 		})
-	})
+	}
 
 	if len(importPaths) == 0 {
 		return nil, i18n.UI.ERRORS.NO_IMPORT_PATHS.Error()
 	}
 
-	fileSet = token.NewFileSet()
+	var buildFlags []string
+
+	if len(tags) != 0 {
+		buildFlags = []string{"-tags=" + strings.Join(tags, ",")}
+	}
+
+	fileSet := token.NewFileSet()
 
 	pkgs, err := packages.Load(&packages.Config{
-		Mode:  packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
-		Fset:  fileSet,
-		Tests: false,
+		Mode:       packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
+		Fset:       fileSet,
+		Dir:        opts.Dir,
+		BuildFlags: buildFlags,
+		Tests:      false,
 	}, importPaths...)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return processPackages(pkgs)
+	r, err := processPackages(fileSet, pkgs, filter, opts)
+
+	filter.Apply(r)
+
+	return r, err
 }
 
 // GetMaxAlign returns MaxAlign
 func GetMaxAlign() int64 {
-	if Sizes == nil {
+	return GetSizesMaxAlign(Sizes)
+}
+
+// GetSizesMaxAlign returns MaxAlign of given sizes model
+func GetSizesMaxAlign(sizes types.Sizes) int64 {
+	if sizes == nil {
 		return 8
 	}
 
-	t, ok := Sizes.(*types.StdSizes)
+	t, ok := sizes.(*types.StdSizes)
 
 	if ok {
 		return t.MaxAlign
 	}
 
 	// Get MaxAlign from private struct like *types.gcSizes
-	ptr := reflect.ValueOf(Sizes)
+	ptr := reflect.ValueOf(sizes)
 
 	if ptr.IsValid() {
 		f := reflect.Indirect(ptr).FieldByName("MaxAlign")
@@ -106,36 +161,49 @@ func GetMaxAlign() int64 {
 	return 8
 }
 
-// ////////////////////////////////////////////////////////////////////////////////// //
-
-// processPackages checks given packages and returns report for them
-func processPackages(pkgs []*packages.Package) (*report.Report, error) {
-	result := &report.Report{}
-
-	for _, pkg := range pkgs {
-		pkgInfo, err := processPackage(pkg)
+// ProcessFile loads package which contains given file and returns report for it.
+// Overlay contains contents of files which are not saved to disk yet.
+func ProcessFile(file string, overlay map[string][]byte, opts *Options) (*report.Report, error) {
+	fileSet := token.NewFileSet()
 
-		if err != nil {
-			return nil, err
-		}
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:    packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
+		Fset:    fileSet,
+		Dir:     filepath.Dir(file),
+		Overlay: overlay,
+		Tests:   false,
+	}, "file="+file)
 
-		result.Packages = append(result.Packages, pkgInfo)
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	return processPackages(fileSet, pkgs, nil, opts)
+}
+
+// ProcessFiles checks given parsed and type-checked files of package
+// and returns report for them
+func ProcessFiles(fset *token.FileSet, pkgPath string, files []*ast.File, typesInfo *types.Info, opts *Options) *report.Package {
+	return processFiles(fset, pkgPath, files, typesInfo, nil, opts)
 }
 
-// processPackage checks given package and returns report for it
-func processPackage(pkg *packages.Package) (*report.Package, error) {
+// processFiles checks given files of package. Filter is used only for
+// counting skipped structs from generated files.
+func processFiles(fset *token.FileSet, pkgPath string, files []*ast.File, typesInfo *types.Info, filter *Filter, opts *Options) *report.Package {
 	var strName string
+	var strObj types.Object
 	var strPos token.Position
-	var strIgnore bool
+	var strIgnore, strABI bool
+	var strBudgets map[string]int64
+	var strUnknown []string
 
-	result := &report.Package{Path: pkg.ID}
-	mappings := map[string]string{pkg.ID + ".": ""}
+	result := &report.Package{Path: pkgPath}
+	mappings := map[string]string{pkgPath + ".": ""}
+	serialized := findSerializedTypes(files, typesInfo)
 
-	for _, file := range pkg.Syntax {
-		commentMap := ast.NewCommentMap(fileSet, file, file.Comments)
+	for _, file := range files {
+		commentMap := ast.NewCommentMap(fset, file, file.Comments)
+		generated := !opts.IncludeGenerated && ast.IsGenerated(file)
 
 		ast.Inspect(file, func(node ast.Node) bool {
 			switch nt := node.(type) {
@@ -143,8 +211,11 @@ func processPackage(pkg *packages.Package) (*report.Package, error) {
 				if nt.Tok == token.TYPE {
 					decl := nt.Specs[0].(*ast.TypeSpec)
 					strName = decl.Name.Name
-					strPos = fileSet.Position(nt.TokPos)
-					strIgnore = checkIgnoreFlag(commentMap.Filter(nt))
+					strObj = typesInfo.Defs[decl.Name]
+					strPos = fset.Position(nt.TokPos)
+					strIgnore = checkFlag(commentMap.Filter(nt), IGNORE_FLAG)
+					strABI = checkFlag(commentMap.Filter(nt), ABI_FLAG)
+					strBudgets, strUnknown = getSizeBudgets(commentMap.Filter(nt))
 				}
 
 			case *ast.ImportSpec:
@@ -163,13 +234,49 @@ func processPackage(pkg *packages.Package) (*report.Package, error) {
 					return true // ignore unnamed structs defined in methods
 				}
 
+				strType, ok := typesInfo.Types[nt].Type.(*types.Struct)
+
+				if !ok {
+					strName = ""
+					return true // ignore structs with type errors
+				}
+
+				if generated {
+					skipped := &report.Struct{Name: strName, Position: ConvertPosition(strPos)}
+
+					if filter.IsAllowedStruct(pkgPath, skipped) {
+						result.Generated++
+					}
+
+					// Name is reset, so anonymous structs in fields of skipped
+					// struct aren't counted as another struct with this name
+					strName = ""
+					return true // skip structs from generated files
+				}
+
 				info := &structInfo{
+					Fset:     fset,
+					Options:  opts,
 					Name:     strName,
-					Type:     pkg.TypesInfo.Types[nt].Type.(*types.Struct),
+					Type:     strType,
 					AST:      nt,
 					Pos:      strPos,
 					Mappings: mappings,
+					Comments: file.Comments,
 					Skip:     strIgnore,
+					ABI:      strABI,
+					Budgets:  strBudgets,
+					Unknown:  strUnknown,
+				}
+
+				info.Formats = getTagFormats(info.Type)
+
+				if tn, ok := strObj.(*types.TypeName); ok {
+					for _, format := range serialized[tn] {
+						if !slices.Contains(info.Formats, format) {
+							info.Formats = append(info.Formats, format)
+						}
+					}
 				}
 
 				structReport := getStructReport(info)
@@ -185,6 +292,66 @@ func processPackage(pkg *packages.Package) (*report.Package, error) {
 		})
 	}
 
+	return result
+}
+
+// FindStructNodes finds struct declarations in the same way as inspector does
+// and maps them to their positions
+func FindStructNodes(fset *token.FileSet, files []*ast.File) map[report.Position]*StructNode {
+	result := map[report.Position]*StructNode{}
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			decl, ok := node.(*ast.GenDecl)
+
+			if !ok || decl.Tok != token.TYPE || len(decl.Specs) == 0 {
+				return true
+			}
+
+			var strType *ast.StructType
+
+			ast.Inspect(decl.Specs[0], func(n ast.Node) bool {
+				if st, ok := n.(*ast.StructType); ok && strType == nil {
+					strType = st
+				}
+
+				return strType == nil
+			})
+
+			if strType != nil {
+				result[ConvertPosition(fset.Position(decl.TokPos))] = &StructNode{decl, strType}
+			}
+
+			return true
+		})
+	}
+
+	return result
+}
+
+// ConvertPosition converts position between types
+func ConvertPosition(pos token.Position) report.Position {
+	return report.Position{
+		File:   path.Base(pos.Filename),
+		Path:   pos.Filename,
+		Line:   pos.Line,
+		Column: pos.Column,
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// processPackages checks given packages and returns report for them
+func processPackages(fset *token.FileSet, pkgs []*packages.Package, filter *Filter, opts *Options) (*report.Report, error) {
+	result := &report.Report{}
+
+	for _, pkg := range pkgs {
+		result.Packages = append(
+			result.Packages,
+			processFiles(fset, pkg.ID, pkg.Syntax, pkg.TypesInfo, filter, opts),
+		)
+	}
+
 	return result, nil
 }
 
@@ -192,10 +359,12 @@ func processPackage(pkg *packages.Package) (*report.Package, error) {
 func getStructReport(info *structInfo) *report.Struct {
 	result := &report.Struct{
 		Name:     info.Name,
-		Position: convertPosition(info.Pos),
+		Position: ConvertPosition(info.Pos),
 		Ignore:   info.Skip,
+		ABI:      info.ABI || hasFixedLayout(info.Type),
 	}
 
+	sizes := info.Options.Sizes
 	numFields := info.Type.NumFields()
 
 	// Recover from panic of checking size of non-generic types
@@ -205,8 +374,8 @@ func getStructReport(info *structInfo) *report.Struct {
 		f := info.Type.Field(i)
 		fs := findFieldInfo(info.AST.Fields.List, i, f.Name())
 		utyp := f.Type().Underlying()
-		size := Sizes.Sizeof(utyp)
-		comm := strings.Trim(fs.Comment.Text(), "\n\r")
+		size := sizes.Sizeof(utyp)
+		comm := stripFieldAnnotation(fs.Comment.Text())
 		typ := formatValueType(f.Type().String(), info.Mappings)
 
 		result.Fields = append(
@@ -221,20 +390,89 @@ func getStructReport(info *structInfo) *report.Struct {
 		)
 	}
 
-	result.Size = Sizes.Sizeof(info.Type)
+	result.Size = sizes.Sizeof(info.Type)
+	result.ArchSizes = getArchSizes(info.Type, info.Options.Archs)
+
+	setFieldsLayout(sizes, info.Type, result.Fields, getFieldsOrder(result.Fields, result.Fields), result.Size)
+
+	if result.ABI {
+		result.Warnings = checkABILayout(info.Type, info.Options.Archs)
+	}
+
+	result.Warnings = append(result.Warnings, checkSizeBudgets(info.Type, info.Budgets, info.Options.Archs)...)
+	result.Warnings = append(result.Warnings, getUnknownArchWarnings(info.Unknown)...)
+
+	serialFields := getSerializedFields(info.Type, info.Formats)
+	lockedFields := serialFields
+
+	if !info.Options.KeepTaggedOrder {
+		lockedFields = nil
+	}
+
+	alnSize, alnFields := getAlignedFields(sizes, info.Type, result.Fields, lockedFields)
+
+	if alnSize < result.Size {
+		order := getFieldsOrder(result.Fields, alnFields)
+
+		for i, f := range alnFields {
+			alnField := *f
+			alnFields[i] = &alnField
+		}
 
-	alnSize, alnFields := getAlignedFields(info.Type, result.Fields)
+		setFieldsLayout(sizes, info.Type, alnFields, order, alnSize)
 
-	if alnSize != result.Size {
 		result.OptimalSize = alnSize
 		result.AlignedFields = alnFields
+
+		// Suggestion can't be generated without losing comments which are
+		// not attached to fields
+		if !hasFloatingComments(info.AST, info.Comments) {
+			result.Suggestion = getSuggestion(info.Fset, info.AST, order)
+		}
 	} else {
 		result.OptimalSize = result.Size
 	}
 
+	result.Warnings = append(result.Warnings, checkSerializationOrder(
+		result.Fields, result.AlignedFields, serialFields, info.Formats,
+	)...)
+
+	return result
+}
+
+// getFieldsOrder returns original indexes of aligned fields
+func getFieldsOrder(fields, alignedFields []*report.Field) []int {
+	result := make([]int, len(alignedFields))
+
+	for i, f := range alignedFields {
+		result[i] = slices.Index(fields, f)
+	}
+
 	return result
 }
 
+// setFieldsLayout sets offsets and padding of fields placed in given order
+func setFieldsLayout(sizes types.Sizes, str *types.Struct, fields []*report.Field, order []int, size int64) {
+	vars := make([]*types.Var, len(order))
+
+	for i, index := range order {
+		vars[i] = str.Field(index)
+	}
+
+	offsets := sizes.Offsetsof(vars)
+
+	for i, f := range fields {
+		end := size
+
+		if i+1 < len(fields) {
+			end = offsets[i+1]
+		}
+
+		f.Offset = offsets[i]
+		f.Padding = end - offsets[i] - f.Size
+	}
+}
+
 // findFieldInfo tries to find field info in fields slice
 func findFieldInfo(list []*ast.Field, index int, name string) *ast.Field {
 	for _, field := range list {
@@ -248,31 +486,79 @@ func findFieldInfo(list []*ast.Field, index int, name string) *ast.Field {
 	return list[index]
 }
 
-// getAlignedFields tries to find optimal field order
-func getAlignedFields(str *types.Struct, origFields []*report.Field) (int64, []*report.Field) {
+// getAlignedFields tries to find optimal field order. Fields marked as locked
+// keep their original relative order. Order with locked fields is found by
+// exhaustive search, and only for structs with too many different fields it
+// is found heuristically.
+func getAlignedFields(sizes types.Sizes, str *types.Struct, origFields []*report.Field, locked []bool) (int64, []*report.Field) {
 	numFields := len(origFields)
 	fields := append(origFields[:0:0], origFields...)
 	vars := make([]*types.Var, numFields)
 	aligns := make([]int64, numFields)
-	sizes := make([]int64, numFields)
+	fieldSizes := make([]int64, numFields)
+	locks := make([]bool, numFields)
 
 	for i := 0; i < numFields; i++ {
 		fieldVar := str.Field(i)
 		vars[i] = fieldVar
-		aligns[i] = Sizes.Alignof(fieldVar.Type())
-		sizes[i] = fields[i].Size
+		aligns[i] = sizes.Alignof(fieldVar.Type())
+		fieldSizes[i] = fields[i].Size
+		locks[i] = locked != nil && locked[i]
+	}
+
+	var lockedOrder []int
+
+	if locked != nil {
+		lockedOrder = getLockedOrder(aligns, fieldSizes, locks)
+	}
+
+	sort.Stable(&optimalSorter{vars, fields, aligns, fieldSizes, locks})
+
+	if locked != nil {
+		restoreLockedOrder(str, origFields, locked, vars, fields, locks)
+	}
+
+	size := sizes.Sizeof(types.NewStruct(vars, nil))
+
+	if lockedOrder == nil {
+		return size, fields
+	}
+
+	orderVars := make([]*types.Var, numFields)
+	orderFields := make([]*report.Field, numFields)
+
+	for i, index := range lockedOrder {
+		orderVars[i], orderFields[i] = str.Field(index), origFields[index]
 	}
 
-	sort.Stable(&optimalSorter{vars, fields, aligns, sizes})
+	// Heuristic order still can be better if locked zero-size field is
+	// the last one
+	orderSize := sizes.Sizeof(types.NewStruct(orderVars, nil))
 
-	return Sizes.Sizeof(types.NewStruct(vars, nil)), fields
+	if orderSize > size {
+		return size, fields
+	}
+
+	return orderSize, orderFields
 }
 
-// convertPosition converts position between types
-func convertPosition(pos token.Position) report.Position {
-	return report.Position{
-		File: path.Base(pos.Filename),
-		Line: pos.Line,
+// restoreLockedOrder puts locked fields back into their original relative order
+// using slots occupied by locked fields after sorting. Result is not always
+// optimal, so it is used only if exhaustive search is too expensive.
+func restoreLockedOrder(str *types.Struct, origFields []*report.Field, locked []bool, vars []*types.Var, fields []*report.Field, locks []bool) {
+	var index int
+
+	for i := range origFields {
+		if !locked[i] {
+			continue
+		}
+
+		for index < len(locks) && !locks[index] {
+			index++
+		}
+
+		vars[index], fields[index] = str.Field(i), origFields[i]
+		index++
 	}
 }
 
@@ -308,15 +594,15 @@ func formatPackageName(p string) string {
 	return p
 }
 
-// checkIgnoreFlag checks struct comments for ignore flag
-func checkIgnoreFlag(cm ast.CommentMap) bool {
+// checkFlag checks struct comments for given flag
+func checkFlag(cm ast.CommentMap, flag string) bool {
 	if cm == nil || len(cm.Comments()) == 0 {
 		return false
 	}
 
 	for _, cg := range cm.Comments() {
 		for _, c := range cg.List {
-			if strings.Contains(strings.ToLower(c.Text), IGNORE_FLAG) {
+			if strings.Contains(strings.ToLower(c.Text), flag) {
 				return true
 			}
 		}
@@ -333,6 +619,7 @@ type optimalSorter struct {
 	Fields []*report.Field
 	Aligns []int64
 	Sizes  []int64
+	Locks  []bool
 }
 
 func (s *optimalSorter) Len() int {
@@ -344,6 +631,7 @@ func (s *optimalSorter) Swap(i, j int) {
 	s.Fields[i], s.Fields[j] = s.Fields[j], s.Fields[i]
 	s.Aligns[i], s.Aligns[j] = s.Aligns[j], s.Aligns[i]
 	s.Sizes[i], s.Sizes[j] = s.Sizes[j], s.Sizes[i]
+	s.Locks[i], s.Locks[j] = s.Locks[j], s.Locks[i]
 }
 
 func (s *optimalSorter) Less(i, j int) bool {
@@ -0,0 +1,179 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import "math"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// maxOrderStates is maximum number of states checked while searching for
+// optimal order of fields with locked relative order
+const maxOrderStates = 1 << 20
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// orderSearch contains state of search for optimal order of fields
+type orderSearch struct {
+	aligns   []int64
+	sizes    []int64
+	chain    []int   // indexes of locked fields in original order
+	classes  [][]int // indexes of unlocked fields grouped by layout class
+	radix    []int   // radix of every class in encoded number of used fields
+	memo     []int64
+	total    int // number of combinations of used fields
+	maxAlign int64
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getLockedOrder returns order of fields with minimal padding where locked
+// fields keep their original relative order. It returns nil if struct has
+// too many different fields for exhaustive search.
+//
+// Padding before field depends only on offset modulo maximum alignment and
+// size of every field is a multiple of its alignment, so unlocked fields with
+// the same alignment and the same size modulo maximum alignment are
+// interchangeable and can be grouped into a class.
+func getLockedOrder(aligns, sizes []int64, locked []bool) []int {
+	s := &orderSearch{aligns: aligns, sizes: sizes, maxAlign: 1}
+
+	for _, align := range aligns {
+		s.maxAlign = max(s.maxAlign, align)
+	}
+
+	var zero []int
+
+	classIndex := map[[2]int64]int{}
+
+	for i := range aligns {
+		switch {
+		case locked[i]:
+			s.chain = append(s.chain, i)
+		case sizes[i] == 0:
+			zero = append(zero, i) // zero-size fields don't affect layout at start
+		default:
+			key := [2]int64{aligns[i], sizes[i] % s.maxAlign}
+			class, ok := classIndex[key]
+
+			if !ok {
+				class = len(s.classes)
+				classIndex[key] = class
+				s.classes = append(s.classes, nil)
+			}
+
+			s.classes[class] = append(s.classes[class], i)
+		}
+	}
+
+	states := (len(s.chain) + 1) * int(s.maxAlign)
+	s.total = 1
+	s.radix = make([]int, len(s.classes))
+
+	for class, fields := range s.classes {
+		s.radix[class] = s.total
+		s.total *= len(fields) + 1
+
+		if s.total*states > maxOrderStates {
+			return nil
+		}
+	}
+
+	s.memo = make([]int64, s.total*states)
+
+	for i := range s.memo {
+		s.memo[i] = -1
+	}
+
+	return append(zero, s.getOrder()...)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getOrder restores order of fields with minimal padding
+func (s *orderSearch) getOrder() []int {
+	var result []int
+	var index, used int
+	var offset int64
+
+	for index < len(s.chain) || used < s.total-1 {
+		best := s.getPadding(index, used, offset)
+
+		if index < len(s.chain) {
+			field := s.chain[index]
+			padding, next := s.place(field, offset)
+
+			if padding+s.getPadding(index+1, used, next) == best {
+				result = append(result, field)
+				index, offset = index+1, next
+				continue
+			}
+		}
+
+		for class, fields := range s.classes {
+			count := (used / s.radix[class]) % (len(fields) + 1)
+
+			if count == len(fields) {
+				continue
+			}
+
+			padding, next := s.place(fields[count], offset)
+
+			if padding+s.getPadding(index, used+s.radix[class], next) == best {
+				result = append(result, fields[count])
+				used, offset = used+s.radix[class], next
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// getPadding returns minimal padding required for placing all remaining
+// fields after given number of locked fields and given combination of
+// unlocked fields are placed
+func (s *orderSearch) getPadding(index, used int, offset int64) int64 {
+	if index == len(s.chain) && used == s.total-1 {
+		return (s.maxAlign - offset) % s.maxAlign // trailing padding
+	}
+
+	key := (index*s.total+used)*int(s.maxAlign) + int(offset)
+
+	if s.memo[key] != -1 {
+		return s.memo[key]
+	}
+
+	best := int64(math.MaxInt64)
+
+	if index < len(s.chain) {
+		padding, next := s.place(s.chain[index], offset)
+		best = padding + s.getPadding(index+1, used, next)
+	}
+
+	for class, fields := range s.classes {
+		count := (used / s.radix[class]) % (len(fields) + 1)
+
+		if count == len(fields) {
+			continue
+		}
+
+		padding, next := s.place(fields[count], offset)
+		best = min(best, padding+s.getPadding(index, used+s.radix[class], next))
+	}
+
+	s.memo[key] = best
+
+	return best
+}
+
+// place returns padding before field placed at given offset and offset
+// after the field modulo maximum alignment
+func (s *orderSearch) place(field int, offset int64) (int64, int64) {
+	padding := (s.aligns[field] - offset%s.aligns[field]) % s.aligns[field]
+	return padding, (offset + padding + s.sizes[field]) % s.maxAlign
+}
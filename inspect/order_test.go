@@ -0,0 +1,208 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"go/token"
+	"go/types"
+	"slices"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestGetLockedOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		aligns []int64
+		sizes  []int64
+		locked []bool
+		size   int64
+	}{
+		{
+			"no locked fields",
+			[]int64{1, 8, 1, 4},
+			[]int64{1, 8, 1, 4},
+			[]bool{false, false, false, false},
+			16,
+		},
+		{
+			// bool, int64 (locked), bool, int32 (locked), bool
+			"locked fields around unlocked",
+			[]int64{1, 8, 1, 4, 1},
+			[]int64{1, 8, 1, 4, 1},
+			[]bool{false, true, false, true, false},
+			16,
+		},
+		{
+			// int32 (locked), int64, bool (locked), int32, int16
+			"locked fields in wrong order for sorting",
+			[]int64{4, 8, 1, 4, 2},
+			[]int64{4, 8, 1, 4, 2},
+			[]bool{true, false, true, false, false},
+			24,
+		},
+		{
+			"all fields locked",
+			[]int64{1, 8, 1},
+			[]int64{1, 8, 1},
+			[]bool{true, true, true},
+			24,
+		},
+		{
+			"arrays and zero-size fields",
+			[]int64{1, 4, 8, 2, 1},
+			[]int64{3, 0, 24, 6, 1},
+			[]bool{true, false, false, false, true},
+			40,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := getLockedOrder(tt.aligns, tt.sizes, tt.locked)
+
+			if len(order) != len(tt.aligns) {
+				t.Fatalf("got order %v for %d fields", order, len(tt.aligns))
+			}
+
+			var lockedOrder []int
+
+			for _, index := range order {
+				if tt.locked[index] {
+					lockedOrder = append(lockedOrder, index)
+				}
+			}
+
+			if !slices.IsSorted(lockedOrder) {
+				t.Errorf("locked fields order %v is changed", lockedOrder)
+			}
+
+			size := getTestLayoutSize(tt.aligns, tt.sizes, order)
+			best := getTestBestSize(tt.aligns, tt.sizes, tt.locked)
+
+			if size != tt.size || size != best {
+				t.Errorf("got size %d for order %v, want %d (brute force: %d)", size, order, tt.size, best)
+			}
+		})
+	}
+}
+
+func TestGetLockedOrderLimit(t *testing.T) {
+	var aligns, sizes []int64
+
+	// Fields from all 15 possible layout classes
+	for range 4 {
+		for _, align := range []int64{1, 2, 4, 8} {
+			for size := align; size <= 8; size += align {
+				aligns = append(aligns, align)
+				sizes = append(sizes, size)
+			}
+		}
+	}
+
+	if order := getLockedOrder(aligns, sizes, make([]bool, len(aligns))); order != nil {
+		t.Errorf("got order for struct with too many states")
+	}
+}
+
+func TestAlignedFieldsWithLockedOrder(t *testing.T) {
+	pkg := types.NewPackage("example.com/test", "test")
+	typ := func(kind types.BasicKind) types.Type { return types.Typ[kind] }
+
+	var vars []*types.Var
+	var fields []*report.Field
+
+	sizes := types.SizesFor("gc", "amd64")
+
+	for i, kind := range []types.BasicKind{types.Int32, types.Int64, types.Bool, types.Int32, types.Int16} {
+		name := string(rune('A' + i))
+		vars = append(vars, types.NewField(token.NoPos, pkg, name, typ(kind), false))
+		fields = append(fields, &report.Field{Name: name, Size: sizes.Sizeof(typ(kind))})
+	}
+
+	str := types.NewStruct(vars, nil)
+	size, aligned := getAlignedFields(sizes, str, fields, []bool{true, false, true, false, false})
+
+	var names []string
+
+	for _, f := range aligned {
+		names = append(names, f.Name)
+	}
+
+	if size != 24 {
+		t.Errorf("got size %d with order %v, want 24", size, names)
+	}
+
+	if slices.Index(names, "A") > slices.Index(names, "C") {
+		t.Errorf("locked fields order is changed: %v", names)
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getTestLayoutSize returns size of struct with fields in given order
+func getTestLayoutSize(aligns, sizes []int64, order []int) int64 {
+	var offset, maxAlign int64 = 0, 1
+
+	for _, index := range order {
+		offset = alignTo(offset, aligns[index]) + sizes[index]
+		maxAlign = max(maxAlign, aligns[index])
+	}
+
+	return alignTo(offset, maxAlign)
+}
+
+// getTestBestSize returns minimal size of struct found by checking all
+// permutations which keep order of locked fields
+func getTestBestSize(aligns, sizes []int64, locked []bool) int64 {
+	best := int64(-1)
+	order := make([]int, len(aligns))
+
+	for i := range order {
+		order[i] = i
+	}
+
+	var permute func(k int)
+
+	permute = func(k int) {
+		if k == len(order) {
+			var last = -1
+
+			for _, index := range order {
+				if locked[index] {
+					if index < last {
+						return
+					}
+
+					last = index
+				}
+			}
+
+			size := getTestLayoutSize(aligns, sizes, order)
+
+			if best == -1 || size < best {
+				best = size
+			}
+
+			return
+		}
+
+		for i := k; i < len(order); i++ {
+			order[k], order[i] = order[i], order[k]
+			permute(k + 1)
+			order[k], order[i] = order[i], order[k]
+		}
+	}
+
+	permute(0)
+
+	return best
+}
@@ -0,0 +1,210 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// KeepTaggedOrder enables optimizer mode which keeps original relative order of
+// serialized fields
+var KeepTaggedOrder bool
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// serialTags is a list of struct tags used by encoders which emit fields in
+// declaration order
+var serialTags = []string{"json", "xml", "yaml", "csv"}
+
+// encoderFuncs is a map of encoder functions and methods with names of formats
+var encoderFuncs = map[string]string{
+	"encoding/json.Marshal":           "json",
+	"encoding/json.MarshalIndent":     "json",
+	"(*encoding/json.Encoder).Encode": "json",
+
+	"encoding/xml.Marshal":           "xml",
+	"encoding/xml.MarshalIndent":     "xml",
+	"(*encoding/xml.Encoder).Encode": "xml",
+
+	"gopkg.in/yaml.v2.Marshal":           "yaml",
+	"(*gopkg.in/yaml.v2.Encoder).Encode": "yaml",
+	"gopkg.in/yaml.v3.Marshal":           "yaml",
+	"(*gopkg.in/yaml.v3.Encoder).Encode": "yaml",
+	"sigs.k8s.io/yaml.Marshal":           "yaml",
+	"github.com/goccy/go-yaml.Marshal":   "yaml",
+
+	"github.com/gocarina/gocsv.Marshal":       "csv",
+	"github.com/gocarina/gocsv.MarshalBytes":  "csv",
+	"github.com/gocarina/gocsv.MarshalFile":   "csv",
+	"github.com/gocarina/gocsv.MarshalString": "csv",
+}
+
+// printfFuncs is a map of fmt functions with index of format argument
+var printfFuncs = map[string]int{
+	"fmt.Printf":  0,
+	"fmt.Sprintf": 0,
+	"fmt.Errorf":  0,
+	"fmt.Fprintf": 1,
+	"fmt.Appendf": 1,
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// findSerializedTypes finds types which are passed to encoders
+func findSerializedTypes(files []*ast.File, info *types.Info) map[*types.TypeName][]string {
+	result := map[*types.TypeName][]string{}
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			fn := typeutil.StaticCallee(info, call)
+
+			if fn == nil {
+				return true
+			}
+
+			if format, ok := encoderFuncs[fn.FullName()]; ok {
+				for _, arg := range call.Args {
+					addSerializedType(result, info.TypeOf(arg), format)
+				}
+			}
+
+			if index, ok := printfFuncs[fn.FullName()]; ok && len(call.Args) > index {
+				fv := info.Types[call.Args[index]].Value
+
+				if fv != nil && fv.Kind() == constant.String &&
+					strings.Contains(constant.StringVal(fv), "%+v") {
+					for _, arg := range call.Args[index+1:] {
+						addSerializedType(result, info.TypeOf(arg), "fmt")
+					}
+				}
+			}
+
+			return true
+		})
+	}
+
+	return result
+}
+
+// addSerializedType adds named type (or type of elements) to the map
+func addSerializedType(result map[*types.TypeName][]string, typ types.Type, format string) {
+	switch t := types.Unalias(typ).(type) {
+	case *types.Pointer:
+		addSerializedType(result, t.Elem(), format)
+	case *types.Slice:
+		addSerializedType(result, t.Elem(), format)
+	case *types.Array:
+		addSerializedType(result, t.Elem(), format)
+	case *types.Map:
+		addSerializedType(result, t.Elem(), format)
+	case *types.Named:
+		obj := t.Origin().Obj()
+
+		if !slices.Contains(result[obj], format) {
+			result[obj] = append(result[obj], format)
+		}
+	}
+}
+
+// getTagFormats returns formats used in struct tags
+func getTagFormats(str *types.Struct) []string {
+	var result []string
+
+	for i := range str.NumFields() {
+		tag := reflect.StructTag(str.Tag(i))
+
+		for _, format := range serialTags {
+			if _, ok := tag.Lookup(format); ok && !slices.Contains(result, format) {
+				result = append(result, format)
+			}
+		}
+	}
+
+	return result
+}
+
+// hasSerialTag returns true if tag contains any of tags used by encoders
+func hasSerialTag(tag string) bool {
+	for _, format := range serialTags {
+		if _, ok := reflect.StructTag(tag).Lookup(format); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getSerializedFields returns flags for fields which are emitted by encoders
+func getSerializedFields(str *types.Struct, formats []string) []bool {
+	if len(formats) == 0 {
+		return nil
+	}
+
+	result := make([]bool, str.NumFields())
+	hasTags := len(getTagFormats(str)) != 0
+
+	for i := range str.NumFields() {
+		f := str.Field(i)
+		result[i] = f.Exported() && (!hasTags || hasSerialTag(str.Tag(i)))
+	}
+
+	return result
+}
+
+// checkSerializationOrder returns warnings if optimal order changes relative
+// order of serialized fields
+func checkSerializationOrder(fields, alignedFields []*report.Field, serialized []bool, formats []string) []*report.Warning {
+	if alignedFields == nil || serialized == nil {
+		return nil
+	}
+
+	var orig, aligned []string
+
+	for i, f := range fields {
+		if serialized[i] {
+			orig = append(orig, f.Name)
+		}
+	}
+
+	for _, f := range alignedFields {
+		if slices.Contains(orig, f.Name) {
+			aligned = append(aligned, f.Name)
+		}
+	}
+
+	if slices.Equal(orig, aligned) {
+		return nil
+	}
+
+	var result []*report.Warning
+
+	for _, format := range formats {
+		result = append(result, &report.Warning{
+			Type: report.WARN_SERIALIZATION, Format: format,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,121 @@
+package inspect
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/printer"
+	"go/token"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// suggestionPrefix is prefix used for formatting struct type as a part of file
+const suggestionPrefix = "package p\n\ntype _ "
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// FieldRef is reference to single field in AST field list
+type FieldRef struct {
+	Field *ast.Field
+	Name  *ast.Ident // nil for embedded fields
+	First bool       // first name in the field declaration
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getSuggestion returns source of struct type with fields in given order
+func getSuggestion(fset *token.FileSet, str *ast.StructType, order []int) string {
+	refs := FlattenFields(str.Fields.List)
+
+	if len(refs) != len(order) {
+		return ""
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString("struct {\n")
+
+	for _, index := range order {
+		ref := refs[index]
+
+		if ref.First && ref.Field.Doc != nil {
+			for _, c := range ref.Field.Doc.List {
+				buf.WriteString(c.Text + "\n")
+			}
+		}
+
+		if ref.Name != nil {
+			buf.WriteString(ref.Name.Name + " ")
+		}
+
+		printer.Fprint(&buf, fset, ref.Field.Type)
+
+		if ref.Field.Tag != nil {
+			buf.WriteString(" " + ref.Field.Tag.Value)
+		}
+
+		if ref.First && ref.Field.Comment != nil {
+			for _, c := range ref.Field.Comment.List {
+				buf.WriteString(" " + c.Text)
+			}
+		}
+
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString("}")
+
+	src, err := format.Source([]byte(suggestionPrefix + buf.String()))
+
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(string(src), suggestionPrefix))
+}
+
+// hasFloatingComments returns true if struct contains comments which are not
+// attached to any of its fields
+func hasFloatingComments(str *ast.StructType, comments []*ast.CommentGroup) bool {
+	attached := map[*ast.CommentGroup]bool{}
+
+	for _, field := range str.Fields.List {
+		attached[field.Doc] = true
+		attached[field.Comment] = true
+	}
+
+	for _, cg := range comments {
+		if cg.Pos() > str.Fields.Opening && cg.End() < str.Fields.Closing && !attached[cg] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FlattenFields returns list with references to every field in AST field list
+func FlattenFields(list []*ast.Field) []FieldRef {
+	var result []FieldRef
+
+	for _, field := range list {
+		if len(field.Names) == 0 {
+			result = append(result, FieldRef{Field: field, First: true})
+			continue
+		}
+
+		for i, name := range field.Names {
+			result = append(result, FieldRef{Field: field, Name: name, First: i == 0})
+		}
+	}
+
+	return result
+}
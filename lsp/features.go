@@ -0,0 +1,368 @@
+package lsp
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/messages"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// MAX_FIELD_SIZE is maximum field size to render in hover
+const MAX_FIELD_SIZE = 128
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// docStruct contains info about struct and its nodes in document
+type docStruct struct {
+	Info *report.Struct
+	Node *inspect.StructNode
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getDiagnostics returns diagnostics for all structs in document
+func (s *Server) getDiagnostics(doc *document) []Diagnostic {
+	result := []Diagnostic{}
+	fset, structs := parseDocument(doc)
+
+	for _, str := range structs {
+		rng := getNodeRange(fset, doc.Text, str.Node.Decl.Specs[0].(*ast.TypeSpec).Name)
+
+		for _, w := range str.Info.ReportedWarnings() {
+			msg := messages.Warning(w)
+
+			result = append(result, Diagnostic{
+				Range:    rng,
+				Source:   "aligo",
+				Code:     w.Type,
+				Message:  msg,
+				Severity: SEVERITY_WARNING,
+			})
+		}
+
+		if !s.isAdvisable(str.Info) {
+			continue
+		}
+
+		result = append(result, Diagnostic{
+			Range:    rng,
+			Source:   "aligo",
+			Code:     "alignment",
+			Message:  messages.Alignment(str.Info),
+			Severity: SEVERITY_WARNING,
+		})
+	}
+
+	return result
+}
+
+// inlayHint returns inlay hints with fields offsets, sizes and padding
+func (s *Server) inlayHint(params json.RawMessage) (any, error) {
+	p := &inlayHintParams{}
+
+	if err := json.Unmarshal(params, p); err != nil {
+		return nil, err
+	}
+
+	result := []InlayHint{}
+	doc := s.docs[p.TextDocument.URI]
+
+	if doc == nil {
+		return result, nil
+	}
+
+	fset, structs := parseDocument(doc)
+
+	for _, str := range structs {
+		refs := inspect.FlattenFields(str.Node.Type.Fields.List)
+
+		if len(refs) != len(str.Info.Fields) {
+			continue
+		}
+
+		for i, f := range str.Info.Fields {
+			pos := getLSPPosition(doc.Text, fset.Position(refs[i].Field.End()).Offset)
+
+			if pos.Line < p.Range.Start.Line || pos.Line > p.Range.End.Line {
+				continue
+			}
+
+			result = append(result, InlayHint{
+				Label:       formatFieldHint(f),
+				Position:    pos,
+				PaddingLeft: true,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// hover returns struct layout visualization
+func (s *Server) hover(params json.RawMessage) (any, error) {
+	p := &hoverParams{}
+
+	if err := json.Unmarshal(params, p); err != nil {
+		return nil, err
+	}
+
+	doc := s.docs[p.TextDocument.URI]
+
+	if doc == nil {
+		return nil, nil
+	}
+
+	fset, structs := parseDocument(doc)
+	offset := getOffset(doc.Text, p.Position)
+
+	for _, str := range structs {
+		name := str.Node.Decl.Specs[0].(*ast.TypeSpec).Name
+
+		if offset < fset.Position(name.Pos()).Offset || offset > fset.Position(name.End()).Offset {
+			continue
+		}
+
+		rng := getNodeRange(fset, doc.Text, name)
+
+		return &Hover{
+			Contents: MarkupContent{
+				Kind:  "markdown",
+				Value: "```\n" + renderLayout(str.Info, s.isAdvisable(str.Info), s.getMaxAlign()) + "```",
+			},
+			Range: &rng,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// codeAction returns code actions for reordering struct fields
+func (s *Server) codeAction(params json.RawMessage) (any, error) {
+	p := &codeActionParams{}
+
+	if err := json.Unmarshal(params, p); err != nil {
+		return nil, err
+	}
+
+	result := []CodeAction{}
+	doc := s.docs[p.TextDocument.URI]
+
+	if doc == nil {
+		return result, nil
+	}
+
+	fset, structs := parseDocument(doc)
+
+	for _, str := range structs {
+		if !s.isAdvisable(str.Info) || str.Info.Suggestion == "" {
+			continue
+		}
+
+		declRange := getNodeRange(fset, doc.Text, str.Node.Decl)
+
+		if declRange.End.Line < p.Range.Start.Line || declRange.Start.Line > p.Range.End.Line {
+			continue
+		}
+
+		result = append(result, CodeAction{
+			Title: fmt.Sprintf("Reorder fields of %s for optimal alignment", str.Info.Name),
+			Kind:  "quickfix",
+			Edit: &WorkspaceEdit{
+				Changes: map[string][]TextEdit{
+					p.TextDocument.URI: {{
+						Range:   getNodeRange(fset, doc.Text, str.Node.Type),
+						NewText: str.Info.Suggestion,
+					}},
+				},
+			},
+			IsPreferred: true,
+		})
+	}
+
+	return result, nil
+}
+
+// isAdvisable returns true if server should advise to reorder struct fields
+func (s *Server) isAdvisable(str *report.Struct) bool {
+	return str.AlignedFields != nil && !str.Ignore && (!str.ABI || s.IncludeABI)
+}
+
+// getMaxAlign returns max alignment of sizes model used by server
+func (s *Server) getMaxAlign() int64 {
+	if s.Options == nil {
+		return inspect.GetMaxAlign()
+	}
+
+	return inspect.GetSizesMaxAlign(s.Options.Sizes)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseDocument parses document and matches struct nodes with report
+func parseDocument(doc *document) (*token.FileSet, []*docStruct) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, doc.Path, doc.Text, parser.ParseComments)
+
+	if err != nil || len(doc.Structs) == 0 {
+		return fset, nil
+	}
+
+	var result []*docStruct
+
+	nodes := inspect.FindStructNodes(fset, []*ast.File{file})
+
+	for _, str := range doc.Structs {
+		node := nodes[str.Position]
+
+		if node != nil {
+			result = append(result, &docStruct{str, node})
+		}
+	}
+
+	return fset, result
+}
+
+// getNodeRange returns range of given node in document
+func getNodeRange(fset *token.FileSet, text []byte, node ast.Node) Range {
+	return Range{
+		Start: getLSPPosition(text, fset.Position(node.Pos()).Offset),
+		End:   getLSPPosition(text, fset.Position(node.End()).Offset),
+	}
+}
+
+// getLSPPosition converts byte offset to LSP position with UTF-16 character index
+func getLSPPosition(text []byte, offset int) Position {
+	var pos Position
+
+	offset = min(offset, len(text))
+	lineStart := 0
+
+	for i := 0; i < offset; i++ {
+		if text[i] == '\n' {
+			pos.Line++
+			lineStart = i + 1
+		}
+	}
+
+	for _, r := range string(text[lineStart:offset]) {
+		pos.Character += len(utf16.Encode([]rune{r}))
+	}
+
+	return pos
+}
+
+// getOffset converts LSP position to byte offset
+func getOffset(text []byte, pos Position) int {
+	var line, offset int
+
+	for offset < len(text) && line < pos.Line {
+		if text[offset] == '\n' {
+			line++
+		}
+
+		offset++
+	}
+
+	for char := 0; offset < len(text) && char < pos.Character && text[offset] != '\n'; {
+		r, size := utf8.DecodeRune(text[offset:])
+		char += len(utf16.Encode([]rune{r}))
+		offset += size
+	}
+
+	return offset
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// formatFieldHint formats inlay hint for field
+func formatFieldHint(f *report.Field) string {
+	if f.Padding > 0 {
+		return fmt.Sprintf("+%d (%dB) pad %dB", f.Offset, f.Size, f.Padding)
+	}
+
+	return fmt.Sprintf("+%d (%dB)", f.Offset, f.Size)
+}
+
+// renderLayout renders struct layout as a plain text
+func renderLayout(str *report.Struct, withOptimal bool, maxAlign int64) string {
+	var buf strings.Builder
+
+	if str.Size != str.OptimalSize {
+		fmt.Fprintf(&buf, "// Size: %d (Optimal: %d)\n", str.Size, str.OptimalSize)
+	} else {
+		fmt.Fprintf(&buf, "// Size: %d\n", str.Size)
+	}
+
+	renderFields(&buf, str.Name, str.Fields, maxAlign)
+
+	if withOptimal {
+		buf.WriteString("\n// Optimal order\n")
+		renderFields(&buf, str.Name, str.AlignedFields, maxAlign)
+	}
+
+	return buf.String()
+}
+
+// renderFields renders fields with byte map
+func renderFields(buf *strings.Builder, name string, fields []*report.Field, maxAlign int64) {
+	var nameSize, typeSize int
+
+	for _, f := range fields {
+		nameSize = max(nameSize, len(f.Name))
+		typeSize = max(typeSize, len(f.Type))
+	}
+
+	placeholder := strings.Repeat(" ", nameSize+typeSize+7)
+
+	fmt.Fprintf(buf, "type %s struct {\n", name)
+
+	for _, f := range fields {
+		fmt.Fprintf(buf, "    %-*s %-*s  ", nameSize, f.Name, typeSize, f.Type)
+
+		if f.Size == 0 {
+			buf.WriteString("\n")
+			continue
+		}
+
+		col := f.Offset % maxAlign
+		buf.WriteString(strings.Repeat("  ", int(col)))
+
+		cells := strings.Repeat("■", int(min(f.Size, MAX_FIELD_SIZE))) + strings.Repeat("□", int(f.Padding))
+
+		for _, cell := range cells {
+			if col == maxAlign {
+				buf.WriteString("\n" + placeholder)
+				col = 0
+			}
+
+			buf.WriteRune(cell)
+			buf.WriteString(" ")
+			col++
+		}
+
+		if f.Size > MAX_FIELD_SIZE {
+			fmt.Fprintf(buf, "--- +%d ---", f.Size-MAX_FIELD_SIZE)
+		}
+
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString("}\n")
+}
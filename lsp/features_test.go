@@ -0,0 +1,283 @@
+package lsp
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/inspect"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// testSavedSource is source saved on disk
+const testSavedSource = `package test
+
+type Data struct {
+	A int64
+}
+`
+
+// testPaddedSource is source with struct which can be optimized
+const testPaddedSource = `package test
+
+type Data struct {
+	A bool
+	B int64
+	C bool
+}
+`
+
+// testAlignedSource is source with optimal struct
+const testAlignedSource = `package test
+
+type Data struct {
+	B int64
+	A bool
+	C bool
+}
+`
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestDiagnostics(t *testing.T) {
+	uri := createTestModule(t)
+
+	tests := []struct {
+		name  string
+		msg   string
+		codes []string
+	}{
+		{"open unsaved document", didOpen(uri, testPaddedSource), []string{"alignment"}},
+		{"change to optimal struct", didChange(uri, 2, testAlignedSource), nil},
+		{"change to padded struct", didChange(uri, 3, testPaddedSource), []string{"alignment"}},
+		{"change to broken source", didChange(uri, 4, "package test\n\ntype Data struct {"), nil},
+		{"close document", didClose(uri), nil},
+	}
+
+	s := newTestServer()
+	input := ""
+
+	for _, tt := range tests {
+		input += tt.msg
+	}
+
+	msgs := runTestSession(t, s, input)
+
+	var diags [][]string
+
+	for _, msg := range msgs {
+		if msg.Method != "textDocument/publishDiagnostics" {
+			continue
+		}
+
+		p := &publishDiagnosticsParams{}
+
+		if err := json.Unmarshal(msg.Params, p); err != nil {
+			t.Fatalf("can't decode diagnostics: %v", err)
+		}
+
+		if p.URI != uri {
+			t.Errorf("got diagnostics for %s, want %s", p.URI, uri)
+		}
+
+		var codes []string
+
+		for _, d := range p.Diagnostics {
+			codes = append(codes, d.Code)
+
+			if d.Range.Start != (Position{2, 5}) || d.Range.End != (Position{2, 9}) {
+				t.Errorf("got diagnostic range %v, want name of struct", d.Range)
+			}
+		}
+
+		diags = append(diags, codes)
+	}
+
+	if len(diags) != len(tests) {
+		t.Fatalf("got %d diagnostics notifications, want %d", len(diags), len(tests))
+	}
+
+	for i, tt := range tests {
+		if strings.Join(diags[i], ",") != strings.Join(tt.codes, ",") {
+			t.Errorf("%s: got diagnostics %v, want %v", tt.name, diags[i], tt.codes)
+		}
+	}
+}
+
+func TestFeatures(t *testing.T) {
+	uri := createTestModule(t)
+
+	tests := []struct {
+		name   string
+		method string
+		params string
+		want   string
+	}{
+		{
+			"hover on struct name",
+			"textDocument/hover",
+			fmt.Sprintf(`{"textDocument":{"uri":%q},"position":{"line":2,"character":7}}`, uri),
+			`{"contents":{"kind":"markdown","value":"` +
+				"```\\n// Size: 16 (Optimal: 12)\\ntype Data struct {\\n" +
+				"    A bool   ■ □ □ □ \\n" +
+				"    B int64  ■ ■ ■ ■ \\n             ■ ■ ■ ■ \\n" +
+				"    C bool   ■ □ □ □ \\n}\\n\\n// Optimal order\\ntype Data struct {\\n" +
+				"    B int64  ■ ■ ■ ■ \\n             ■ ■ ■ ■ \\n" +
+				"    A bool   ■ \\n    C bool     ■ □ □ \\n}\\n```" +
+				`"},"range":{"start":{"line":2,"character":5},"end":{"line":2,"character":9}}}`,
+		},
+		{
+			"hover outside of struct name",
+			"textDocument/hover",
+			fmt.Sprintf(`{"textDocument":{"uri":%q},"position":{"line":3,"character":1}}`, uri),
+			`null`,
+		},
+		{
+			"inlay hints",
+			"textDocument/inlayHint",
+			fmt.Sprintf(`{"textDocument":{"uri":%q},"range":{"start":{"line":0,"character":0},"end":{"line":4,"character":0}}}`, uri),
+			`[{"label":"+0 (1B) pad 3B","position":{"line":3,"character":7},"paddingLeft":true},` +
+				`{"label":"+4 (8B)","position":{"line":4,"character":8},"paddingLeft":true}]`,
+		},
+		{
+			"reorder code action",
+			"textDocument/codeAction",
+			fmt.Sprintf(`{"textDocument":{"uri":%q},"range":{"start":{"line":4,"character":0},"end":{"line":4,"character":0}}}`, uri),
+			`[{"edit":{"changes":{"` + uri + `":[{"range":{"start":{"line":2,"character":10},"end":{"line":6,"character":1}},` +
+				`"newText":"struct {\n\tB int64\n\tA bool\n\tC bool\n}"}]}},` +
+				`"title":"Reorder fields of Data for optimal alignment","kind":"quickfix","isPreferred":true}]`,
+		},
+		{
+			"code action outside of struct",
+			"textDocument/codeAction",
+			fmt.Sprintf(`{"textDocument":{"uri":%q},"range":{"start":{"line":0,"character":0},"end":{"line":1,"character":0}}}`, uri),
+			`[]`,
+		},
+		{
+			"unknown document",
+			"textDocument/inlayHint",
+			`{"textDocument":{"uri":"file:///unknown.go"},"range":{"start":{"line":0,"character":0},"end":{"line":4,"character":0}}}`,
+			`[]`,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := didOpen(uri, testPaddedSource) +
+				frame(fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":%q,"params":%s}`, i+1, tt.method, tt.params))
+
+			var result json.RawMessage
+
+			for _, msg := range runTestSession(t, newTestServer(), input) {
+				if string(msg.ID) == fmt.Sprint(i+1) {
+					result = msg.Result
+				}
+			}
+
+			var want bytes.Buffer
+
+			if err := json.Compact(&want, []byte(tt.want)); err != nil {
+				t.Fatalf("invalid expected result: %v", err)
+			}
+
+			if string(result) != want.String() {
+				t.Errorf("got result:\n%s\nwant:\n%s", result, want.String())
+			}
+		})
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// newTestServer creates server which uses sizes of 386 architecture
+func newTestServer() *Server {
+	return &Server{
+		Name:    "aligo",
+		Version: "test",
+		Options: &inspect.Options{Sizes: types.SizesFor("gc", "386")},
+	}
+}
+
+// createTestModule creates module with saved source and returns URI of file
+func createTestModule(t *testing.T) string {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.go")
+
+	err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/test\n\ngo 1.23\n"), 0644)
+
+	if err == nil {
+		err = os.WriteFile(file, []byte(testSavedSource), 0644)
+	}
+
+	if err != nil {
+		t.Fatalf("can't create module: %v", err)
+	}
+
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(file)}).String()
+}
+
+// runTestSession sends messages to server and returns all messages sent
+// by server
+func runTestSession(t *testing.T, s *Server, input string) []*testMessage {
+	var out bytes.Buffer
+
+	err := s.Serve(strings.NewReader(input), &out)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	msgs, err := readMessages(&out)
+
+	if err != nil {
+		t.Fatalf("can't read server messages: %v", err)
+	}
+
+	return msgs
+}
+
+// didOpen returns didOpen notification
+func didOpen(uri, text string) string {
+	data, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"method":  "textDocument/didOpen",
+		"params": map[string]any{
+			"textDocument": map[string]any{"uri": uri, "languageId": "go", "version": 1, "text": text},
+		},
+	})
+
+	return frame(string(data))
+}
+
+// didChange returns didChange notification with full text of document
+func didChange(uri string, version int, text string) string {
+	data, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"method":  "textDocument/didChange",
+		"params": map[string]any{
+			"textDocument":   map[string]any{"uri": uri, "version": version},
+			"contentChanges": []map[string]any{{"text": text}},
+		},
+	})
+
+	return frame(string(data))
+}
+
+// didClose returns didClose notification
+func didClose(uri string) string {
+	return frame(fmt.Sprintf(`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":%q}}}`, uri))
+}
@@ -0,0 +1,383 @@
+package lsp
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/essentialkaos/aligo/v2/inspect"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Server is language server which provides info about structs alignment
+type Server struct {
+	Name    string // Server name
+	Version string // Server version
+
+	Options    *inspect.Options // Options for sources processing
+	IncludeABI bool             // Show advice for structs with fixed layout
+
+	docs     map[string]*document
+	reader   *bufio.Reader
+	writer   io.Writer
+	shutdown bool
+}
+
+// document contains info about opened text document
+type document struct {
+	Path    string
+	Text    []byte
+	Structs []*report.Struct
+	Version int
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// errExit is returned by handler when client asks server to exit
+var errExit = fmt.Errorf("exit")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Serve runs server using given reader and writer as transport
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.docs = map[string]*document{}
+	s.reader = bufio.NewReader(r)
+	s.writer = w
+
+	for {
+		req, err := s.readRequest()
+
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		if req == nil {
+			continue // malformed message
+		}
+
+		err = s.handleRequest(req)
+
+		if err == errExit {
+			if !s.shutdown {
+				return fmt.Errorf("Client asked server to exit without shutdown")
+			}
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// readRequest reads request from client
+func (s *Server) readRequest() (*request, error) {
+	var size int
+
+	for {
+		line, err := s.reader.ReadString('\n')
+
+		if err != nil {
+			return nil, err
+		}
+
+		line = strings.TrimSpace(line)
+
+		if line == "" {
+			break
+		}
+
+		name, value, ok := strings.Cut(line, ":")
+
+		if ok && strings.EqualFold(name, "Content-Length") {
+			size, _ = strconv.Atoi(strings.TrimSpace(value))
+		}
+	}
+
+	if size <= 0 {
+		return nil, s.sendError(nil, ERR_PARSE, "Message without valid Content-Length header")
+	}
+
+	data := make([]byte, size)
+
+	_, err := io.ReadFull(s.reader, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req := &request{}
+	err = json.Unmarshal(data, req)
+
+	if err != nil {
+		return nil, s.sendError(nil, ERR_PARSE, err.Error())
+	}
+
+	return req, nil
+}
+
+// send sends message to client
+func (s *Server) send(msg any) error {
+	data, err := json.Marshal(msg)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(s.writer, "Content-Length: %d\r\n\r\n%s", len(data), data)
+
+	return err
+}
+
+// sendResult sends response with result to client
+func (s *Server) sendResult(id json.RawMessage, result any) error {
+	return s.send(&response{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+// sendError sends response with error to client
+func (s *Server) sendError(id json.RawMessage, code int, msg string) error {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+
+	return s.send(&errorResponse{
+		JSONRPC: "2.0", ID: id, Error: &responseError{code, msg},
+	})
+}
+
+// notify sends notification to client
+func (s *Server) notify(method string, params any) error {
+	return s.send(&notification{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// handleRequest handles request or notification from client
+func (s *Server) handleRequest(req *request) error {
+	isNotification := len(req.ID) == 0
+
+	var result any
+	var err error
+
+	switch req.Method {
+	case "initialize":
+		result = s.initialize()
+	case "initialized", "$/cancelRequest", "$/setTrace":
+		return nil
+	case "shutdown":
+		s.shutdown = true
+	case "exit":
+		return errExit
+
+	case "textDocument/didOpen":
+		err = s.didOpen(req.Params)
+	case "textDocument/didChange":
+		err = s.didChange(req.Params)
+	case "textDocument/didSave":
+		err = s.didSave(req.Params)
+	case "textDocument/didClose":
+		err = s.didClose(req.Params)
+
+	case "textDocument/inlayHint":
+		result, err = s.inlayHint(req.Params)
+	case "textDocument/hover":
+		result, err = s.hover(req.Params)
+	case "textDocument/codeAction":
+		result, err = s.codeAction(req.Params)
+
+	default:
+		if isNotification {
+			return nil
+		}
+
+		return s.sendError(req.ID, ERR_METHOD_NOT_FOUND, "Method "+req.Method+" is not supported")
+	}
+
+	if isNotification {
+		return nil
+	}
+
+	if err != nil {
+		return s.sendError(req.ID, ERR_INVALID_PARAMS, err.Error())
+	}
+
+	return s.sendResult(req.ID, result)
+}
+
+// initialize returns info about server capabilities
+func (s *Server) initialize() *initializeResult {
+	return &initializeResult{
+		Capabilities: serverCapabilities{
+			TextDocumentSync: textDocumentSyncOptions{
+				Change:    TEXT_SYNC_FULL,
+				OpenClose: true,
+				Save:      true,
+			},
+			CodeActionProvider: codeActionOptions{
+				CodeActionKinds: []string{"quickfix"},
+			},
+			InlayHintProvider: true,
+			HoverProvider:     true,
+		},
+		ServerInfo: serverInfo{s.Name, s.Version},
+	}
+}
+
+// didOpen handles opening of text document
+func (s *Server) didOpen(params json.RawMessage) error {
+	p := &didOpenParams{}
+
+	if err := json.Unmarshal(params, p); err != nil {
+		return err
+	}
+
+	path, err := uriToPath(p.TextDocument.URI)
+
+	if err != nil {
+		return err
+	}
+
+	s.docs[p.TextDocument.URI] = &document{
+		Path:    path,
+		Text:    []byte(p.TextDocument.Text),
+		Version: p.TextDocument.Version,
+	}
+
+	return s.analyze(p.TextDocument.URI)
+}
+
+// didChange handles changes of text document
+func (s *Server) didChange(params json.RawMessage) error {
+	p := &didChangeParams{}
+
+	if err := json.Unmarshal(params, p); err != nil {
+		return err
+	}
+
+	doc := s.docs[p.TextDocument.URI]
+
+	if doc == nil || len(p.ContentChanges) == 0 {
+		return nil
+	}
+
+	// We use full sync, so the last change contains whole document
+	doc.Text = []byte(p.ContentChanges[len(p.ContentChanges)-1].Text)
+	doc.Version = p.TextDocument.Version
+
+	return s.analyze(p.TextDocument.URI)
+}
+
+// didSave handles saving of text document
+func (s *Server) didSave(params json.RawMessage) error {
+	p := &didSaveParams{}
+
+	if err := json.Unmarshal(params, p); err != nil {
+		return err
+	}
+
+	doc := s.docs[p.TextDocument.URI]
+
+	if doc == nil {
+		return nil
+	}
+
+	if p.Text != nil {
+		doc.Text = []byte(*p.Text)
+	}
+
+	return s.analyze(p.TextDocument.URI)
+}
+
+// didClose handles closing of text document
+func (s *Server) didClose(params json.RawMessage) error {
+	p := &didCloseParams{}
+
+	if err := json.Unmarshal(params, p); err != nil {
+		return err
+	}
+
+	delete(s.docs, p.TextDocument.URI)
+
+	return s.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{
+		URI: p.TextDocument.URI, Diagnostics: []Diagnostic{},
+	})
+}
+
+// analyze analyzes document and publishes diagnostics for it
+func (s *Server) analyze(uri string) error {
+	doc := s.docs[uri]
+	overlay := map[string][]byte{}
+
+	for _, d := range s.docs {
+		overlay[d.Path] = d.Text
+	}
+
+	doc.Structs = nil
+
+	r, err := inspect.ProcessFile(doc.Path, overlay, s.Options)
+
+	if err != nil {
+		err = s.notify("window/logMessage", &logMessageParams{
+			Type:    MESSAGE_ERROR,
+			Message: fmt.Sprintf("Can't analyze %s: %v", doc.Path, err),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if r != nil {
+		for _, pkg := range r.Packages {
+			for _, str := range pkg.Structs {
+				if str.Position.Path == doc.Path {
+					doc.Structs = append(doc.Structs, str)
+				}
+			}
+		}
+	}
+
+	return s.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{
+		URI:         uri,
+		Version:     doc.Version,
+		Diagnostics: s.getDiagnostics(doc),
+	})
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// uriToPath converts file URI to path
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+
+	if err != nil {
+		return "", err
+	}
+
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("Unsupported URI scheme %q", u.Scheme)
+	}
+
+	return filepath.FromSlash(u.Path), nil
+}
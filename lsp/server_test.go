@@ -0,0 +1,186 @@
+package lsp
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// testMessage contains fields of server message checked by tests
+type testMessage struct {
+	ID     json.RawMessage `json:"id"`
+	Error  *responseError  `json:"error"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestFraming(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string // ids of responses, "error" for responses with parse error
+	}{
+		{
+			"valid request",
+			frame(`{"jsonrpc":"2.0","id":1,"method":"initialize"}`),
+			[]string{"1"},
+		},
+		{
+			"lowercase header",
+			strings.Replace(
+				frame(`{"jsonrpc":"2.0","id":2,"method":"initialize"}`),
+				"Content-Length:", "content-length:", 1,
+			),
+			[]string{"2"},
+		},
+		{
+			"message without Content-Length",
+			"Content-Type: application/vscode-jsonrpc\r\n\r\n" +
+				frame(`{"jsonrpc":"2.0","id":3,"method":"initialize"}`),
+			[]string{"error", "3"},
+		},
+		{
+			"invalid Content-Length",
+			"Content-Length: abc\r\n\r\n" +
+				frame(`{"jsonrpc":"2.0","id":4,"method":"initialize"}`),
+			[]string{"error", "4"},
+		},
+		{
+			"malformed JSON",
+			frame(`{"jsonrpc":"2.0","id":`) +
+				frame(`{"jsonrpc":"2.0","id":5,"method":"initialize"}`),
+			[]string{"error", "5"},
+		},
+		{
+			"notification",
+			frame(`{"jsonrpc":"2.0","method":"initialized"}`) +
+				frame(`{"jsonrpc":"2.0","id":6,"method":"shutdown"}`),
+			[]string{"6"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			s := &Server{Name: "aligo", Version: "test"}
+			err := s.Serve(strings.NewReader(tt.input), &out)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			msgs, err := readMessages(&out)
+
+			if err != nil {
+				t.Fatalf("can't read server messages: %v", err)
+			}
+
+			var got []string
+
+			for _, msg := range msgs {
+				if msg.Error != nil {
+					if msg.Error.Code != ERR_PARSE {
+						t.Errorf("unexpected error code %d", msg.Error.Code)
+					}
+
+					got = append(got, "error")
+				} else {
+					got = append(got, string(msg.ID))
+				}
+			}
+
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("got responses %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExit(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   bool
+	}{
+		{"exit after shutdown", frame(`{"jsonrpc":"2.0","id":1,"method":"shutdown"}`) + frame(`{"jsonrpc":"2.0","method":"exit"}`), false},
+		{"exit without shutdown", frame(`{"jsonrpc":"2.0","method":"exit"}`), true},
+		{"end of input", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{}
+			err := s.Serve(strings.NewReader(tt.input), io.Discard)
+
+			if (err != nil) != tt.err {
+				t.Errorf("got error %v, want error: %t", err, tt.err)
+			}
+		})
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// frame adds header to message
+func frame(msg string) string {
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(msg), msg)
+}
+
+// readMessages reads all messages sent by server
+func readMessages(r io.Reader) ([]*testMessage, error) {
+	var result []*testMessage
+
+	reader := bufio.NewReader(r)
+
+	for {
+		header, err := reader.ReadString('\n')
+
+		if err == io.EOF {
+			return result, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "Content-Length:")))
+
+		if err != nil {
+			return nil, err
+		}
+
+		reader.ReadString('\n')
+
+		data := make([]byte, size)
+
+		if _, err = io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+
+		msg := &testMessage{}
+
+		if err = json.Unmarshal(data, msg); err != nil {
+			return nil, err
+		}
+
+		result = append(result, msg)
+	}
+}
@@ -0,0 +1,71 @@
+package messages
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"strings"
+
+	"github.com/essentialkaos/ek/v14/fmtc"
+
+	"github.com/essentialkaos/aligo/v2/i18n"
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// WarningText returns localized message about warning with color tags
+func WarningText(w *report.Warning) string {
+	t := i18n.UI.WARNINGS
+
+	switch w.Type {
+	case report.WARN_ABI_OFFSET:
+		return t.ABI_OFFSET.Format(w.Field, w.Arch)
+	case report.WARN_ABI_SIZE:
+		return t.ABI_SIZE.Format(w.Arch)
+	case report.WARN_ABI_ZERO_TAIL:
+		return t.ABI_ZERO_TAIL.Format(w.Field, w.Arch)
+	case report.WARN_SERIALIZATION:
+		return t.SERIALIZATION.Format(w.Format)
+	case report.WARN_MAX_SIZE:
+		return t.MAX_SIZE.Format(w.Size, w.MaxSize, w.Arch, strings.Join(w.Fields, ", "))
+	case report.WARN_BUDGET_ARCH:
+		return t.BUDGET_ARCH.Format(w.Arch)
+	case report.WARN_LOCKED_SIZE:
+		return t.LOCKED_SIZE.Format(w.LockedSize, w.Size, w.Arch)
+	case report.WARN_NOT_LOCKED:
+		return t.NOT_LOCKED.String()
+	}
+
+	return ""
+}
+
+// Warning returns localized plain text message about warning
+func Warning(w *report.Warning) string {
+	return fmtc.Clean(WarningText(w))
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Alignment returns localized plain text message about suboptimal fields order
+func Alignment(s *report.Struct) string {
+	msg := i18n.UI.WARNINGS.ALIGNMENT.Format(s.Name, s.Size, s.OptimalSize)
+
+	for _, w := range s.Warnings {
+		if w.Type == report.WARN_SERIALIZATION {
+			msg += ". " + WarningText(w)
+		}
+	}
+
+	return fmtc.Clean(msg)
+}
+
+// StructWarning returns localized plain text message about warning with
+// struct name
+func StructWarning(s *report.Struct, w *report.Warning) string {
+	return fmtc.Clean(i18n.UI.WARNINGS.STRUCT_WARNING.Format(s.Name, WarningText(w)))
+}
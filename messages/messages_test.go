@@ -0,0 +1,94 @@
+package messages
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2026 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+
+	"github.com/essentialkaos/aligo/v2/report"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestWarning(t *testing.T) {
+	tests := []struct {
+		warning *report.Warning
+		text    string
+		message string
+	}{
+		{
+			&report.Warning{Type: report.WARN_ABI_OFFSET, Field: "B", Arch: "arm"},
+			"Field {*}B{!} has different offsets in Go and C layouts on arm",
+			"Field B has different offsets in Go and C layouts on arm",
+		},
+		{
+			&report.Warning{Type: report.WARN_MAX_SIZE, Size: 24, MaxSize: 16, Arch: "amd64", Fields: []string{"B", "C"}},
+			"Struct size {*}24{!} exceeds budget {*}16{!} on amd64, fields over budget: {*}B, C{!}",
+			"Struct size 24 exceeds budget 16 on amd64, fields over budget: B, C",
+		},
+		{
+			&report.Warning{Type: report.WARN_LOCKED_SIZE, LockedSize: 16, Size: 24, Arch: "386"},
+			"Struct size changed from {*}16{!} to {*}24{!} on 386",
+			"Struct size changed from 16 to 24 on 386",
+		},
+		{
+			&report.Warning{Type: report.WARN_NOT_LOCKED},
+			"Struct is not in lock file",
+			"Struct is not in lock file",
+		},
+		{
+			&report.Warning{Type: "unknown"},
+			"", "",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := WarningText(tt.warning); got != tt.text {
+			t.Errorf("%s: got text %q, want %q", tt.warning.Type, got, tt.text)
+		}
+
+		if got := Warning(tt.warning); got != tt.message {
+			t.Errorf("%s: got message %q, want %q", tt.warning.Type, got, tt.message)
+		}
+	}
+}
+
+func TestStructMessages(t *testing.T) {
+	limit := &report.Warning{Type: report.WARN_MAX_SIZE, Size: 24, MaxSize: 16, Arch: "amd64", Fields: []string{"B"}}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			"alignment",
+			Alignment(&report.Struct{Name: "Data", Size: 24, OptimalSize: 16}),
+			"Struct Data fields order can be optimized (24 → 16)",
+		},
+		{
+			"alignment with serialization",
+			Alignment(&report.Struct{
+				Name: "Data", Size: 24, OptimalSize: 16,
+				Warnings: []*report.Warning{limit, {Type: report.WARN_SERIALIZATION, Format: "json"}},
+			}),
+			"Struct Data fields order can be optimized (24 → 16). Reordering changes order of fields in json output",
+		},
+		{
+			"struct warning",
+			StructWarning(&report.Struct{Name: "Data"}, limit),
+			"Struct Data: Struct size 24 exceeds budget 16 on amd64, fields over budget: B",
+		},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
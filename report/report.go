@@ -13,26 +13,58 @@ import (
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// Warning types
+const (
+	WARN_ABI_OFFSET    = "abi-offset"
+	WARN_ABI_SIZE      = "abi-size"
+	WARN_ABI_ZERO_TAIL = "abi-zero-tail"
+	WARN_SERIALIZATION = "serialization"
+	WARN_MAX_SIZE      = "max-size"
+	WARN_BUDGET_ARCH   = "budget-arch"
+	WARN_LOCKED_SIZE   = "locked-size"
+	WARN_NOT_LOCKED    = "not-locked"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // Report contains aligning info about packages
 type Report struct {
+	Meta     *Meta      `json:"meta"`
 	Packages []*Package `json:"packages"`
 }
 
+// Meta contains info about tool and sizes model used for report generation
+type Meta struct {
+	Tool     string   `json:"tool"`
+	Version  string   `json:"version"`
+	Arch     string   `json:"arch"`
+	Compiler string   `json:"compiler"`
+	Archs    []string `json:"archs"`
+	WordSize int64    `json:"word_size"`
+	MaxAlign int64    `json:"max_align"`
+}
+
 // Package contains info about all structs in package
 type Package struct {
-	Path    string    `json:"path"`
-	Structs []*Struct `json:"structs"`
+	Path      string    `json:"path"`
+	Structs   []*Struct `json:"structs"`
+	Generated int       `json:"generated,omitempty"` // number of skipped structs from generated files
 }
 
 // Struct contains info about fields aligning
 type Struct struct {
-	Name          string   `json:"name"`
-	Position      Position `json:"position"`
-	Fields        []*Field `json:"fields"`
-	AlignedFields []*Field `json:"aligned_fields"` // nil if Size == OptimalSize
-	Size          int64    `json:"size"`
-	OptimalSize   int64    `json:"optimal_size"`
-	Ignore        bool     `json:"ignore"`
+	Name          string           `json:"name"`
+	Position      Position         `json:"position"`
+	Fields        []*Field         `json:"fields"`
+	AlignedFields []*Field         `json:"aligned_fields"` // nil if Size == OptimalSize
+	Suggestion    string           `json:"suggestion"`     // struct type with optimal fields order
+	Warnings      []*Warning       `json:"warnings"`
+	ArchSizes     map[string]int64 `json:"arch_sizes,omitempty"` // struct size on every checked arch
+	Size          int64            `json:"size"`
+	OptimalSize   int64            `json:"optimal_size"`
+	MinWaste      int64            `json:"-"` // minimal number of wasted bytes for reporting struct, set from config
+	Ignore        bool             `json:"ignore"`
+	ABI           bool             `json:"abi"` // struct has fixed (C-compatible) layout
 }
 
 // Field contains info about field
@@ -42,12 +74,29 @@ type Field struct {
 	Tag     string `json:"tag"`
 	Comment string `json:"comment"`
 	Size    int64  `json:"size"`
+	Offset  int64  `json:"offset"`
+	Padding int64  `json:"padding"` // padding after field
+}
+
+// Warning contains info about possible problem with struct layout
+type Warning struct {
+	Type    string   `json:"type"`
+	Arch    string   `json:"arch,omitempty"`
+	Field   string   `json:"field,omitempty"`
+	Format  string   `json:"format,omitempty"`
+	Fields  []string `json:"fields,omitempty"`   // fields which end after size budget
+	Size    int64    `json:"size,omitempty"`     // struct size on arch
+	MaxSize int64    `json:"max_size,omitempty"` // struct size budget
+
+	LockedSize int64 `json:"locked_size,omitempty"` // struct size from lock file
 }
 
 // Position contains info about struct position
 type Position struct {
-	File string `json:"file"`
-	Line int    `json:"line"`
+	File   string `json:"file"`
+	Path   string `json:"path"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -67,6 +116,51 @@ func (r *Report) IsEmpty() bool {
 	return true
 }
 
+// Generated returns number of skipped structs from generated files
+func (r *Report) Generated() int {
+	if r == nil {
+		return 0
+	}
+
+	var result int
+
+	for _, pkg := range r.Packages {
+		result += pkg.Generated
+	}
+
+	return result
+}
+
+// ReportedWarnings returns warnings which must be reported as problems.
+// Size budgets and locked sizes are enforced even for ignored structs.
+func (s *Struct) ReportedWarnings() []*Warning {
+	var result []*Warning
+
+	for _, w := range s.Warnings {
+		switch {
+		case w.Type == WARN_SERIALIZATION:
+			continue
+		case s.Ignore && !isEnforcedWarning(w.Type):
+			continue
+		}
+
+		result = append(result, w)
+	}
+
+	return result
+}
+
+// isEnforcedWarning returns true if warning with given type is reported even
+// for ignored structs
+func isEnforcedWarning(typ string) bool {
+	switch typ {
+	case WARN_MAX_SIZE, WARN_BUDGET_ARCH, WARN_LOCKED_SIZE, WARN_NOT_LOCKED:
+		return true
+	}
+
+	return false
+}
+
 // IsEmpty returns true if package is empty
 func (p *Package) IsEmpty() bool {
 	if p == nil {
@@ -81,8 +175,8 @@ func (p *Package) IsEmpty() bool {
 // String returns string representation of struct
 func (s *Struct) String() string {
 	return fmt.Sprintf(
-		"%s:{Pos: %s:%d | Size: %d | Optimal: %d | Fields: %d | Ignore: %t}",
+		"%s:{Pos: %s:%d | Size: %d | Optimal: %d | Fields: %d | Ignore: %t | ABI: %t}",
 		s.Name, s.Position.File, s.Position.Line, s.Size, s.OptimalSize,
-		len(s.Fields), s.Ignore,
+		len(s.Fields), s.Ignore, s.ABI,
 	)
 }